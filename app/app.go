@@ -14,6 +14,7 @@ import (
 	"github.com/anathatech/project-anatha/x/hra"
 	hraclient "github.com/anathatech/project-anatha/x/hra/client"
 	"github.com/anathatech/project-anatha/x/treasury"
+	"github.com/anathatech/project-anatha/x/treasury/orderbook"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -62,6 +63,11 @@ var (
 			upgradeclient.ProposalHandler,
 			hraclient.RegisterBlockchainIdProposalHandler,
 			hraclient.RemoveBlockchainIdProposalHandler,
+			hraclient.SetPricingScheduleProposalHandler,
+			hraclient.ReserveNamesProposalHandler,
+			hraclient.ReleaseNamesProposalHandler,
+			hraclient.RevokeNameProposalHandler,
+			hraclient.SetNameHistoryRetentionProposalHandler,
 			distributionclient.DevelopmentFundDistributionProposalHandler,
 			distributionclient.SecurityTokenFundDistributionProposalHandler,
 			feeclient.AddFeeExcludedMessageProposalHandler,
@@ -72,6 +78,7 @@ var (
 			treasuryclient.TransferFromDistributionProfitsToBuyBackLiquidityProposalHandler,
 			treasuryclient.TransferFromTreasuryToSwapEscrowProposalHandler,
 			treasuryclient.TransferFromSwapEscrowToBuyBackProposalHandler,
+			treasuryclient.SetBondingCurveProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -93,6 +100,9 @@ var (
 		treasury.DistributionProfitsModuleName:     {supply.Burner},
 		treasury.TreasuryEscrowModuleName:          nil,
 		treasury.SwapEscrowModuleName:              nil,
+		treasury.OrderBookEscrowModuleName:         nil,
+		hra.AuctionEscrowModuleName:                nil,
+		hra.OfferEscrowModuleName:                  nil,
 		staking.BondedPoolName:                     {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName:                  {supply.Burner, supply.Staking},
 		gov.ModuleName:                             nil,
@@ -152,6 +162,7 @@ type AnathaApp struct {
 	upgradeKeeper      upgrade.Keeper
 	evidenceKeeper     evidence.Keeper
 	treasuryKeeper     treasury.Keeper
+	orderBookKeeper    orderbook.Keeper
 	distributionKeeper distribution.Keeper
 	feeKeeper          fee.Keeper
 
@@ -292,6 +303,13 @@ func NewAnathaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		app.bankKeeper,
 	)
 
+	app.orderBookKeeper = orderbook.NewKeeper(
+		app.cdc,
+		keys[treasury.StoreKey],
+		app.treasuryKeeper,
+		app.bankKeeper,
+	)
+
 	app.upgradeKeeper = upgrade.NewKeeper(skipUpgradeHeights, keys[upgrade.StoreKey], app.cdc)
 
 	app.upgradeKeeper.SetUpgradeHandler("swap", func(ctx sdk.Context, plan upgrade.Plan) {
@@ -310,7 +328,7 @@ func NewAnathaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		_ = app.supplyKeeper.SendCoinsFromAccountToModule(ctx, address, treasury.SwapEscrowModuleName, amount)
 
 		// Remove devnet minted buyback liquidity funds
-		app.treasuryKeeper.BurnCoinsFromBuyBackLiquidityFund(ctx, sdk.NewCoins(sdk.NewInt64Coin(appConfig.DefaultStableDenom, 10000000000000)))
+		app.treasuryKeeper.BurnCoinsFromBuyBackLiquidityFund(ctx, plan.Name, sdk.NewCoins(sdk.NewInt64Coin(appConfig.DefaultStableDenom, 10000000000000)))
 
 		app.feeKeeper.SetParams(
 			ctx,
@@ -347,6 +365,40 @@ func NewAnathaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		)
 	})
 
+	app.upgradeKeeper.SetUpgradeHandler("extensions", func(ctx sdk.Context, plan upgrade.Plan) {
+		// Store defaults for the params added since genesis, the existing params keep their values.
+		// GetParams panics while the new keys are missing, so the new params are set one by one.
+		hraDefaults := hra.DefaultParams()
+		hraSubspace := app.subspaces[hra.ModuleName]
+		hraSubspace.Set(ctx, hra.KeyAuctionRevealDuration, hraDefaults.AuctionRevealDuration)
+		hraSubspace.Set(ctx, hra.KeyNameInfoGracePeriod, hraDefaults.NameInfoGracePeriod)
+		hraSubspace.Set(ctx, hra.KeyNameInfoRedemptionPeriod, hraDefaults.NameInfoRedemptionPeriod)
+		hraSubspace.Set(ctx, hra.KeyNameInfoRedemptionFee, hraDefaults.NameInfoRedemptionFee)
+		hraSubspace.Set(ctx, hra.KeySubnameRegistrationFee, hraDefaults.SubnameRegistrationFee)
+		hraSubspace.Set(ctx, hra.KeyTextRecordMaxCount, hraDefaults.TextRecordMaxCount)
+		hraSubspace.Set(ctx, hra.KeyTextRecordMaxValueLength, hraDefaults.TextRecordMaxValueLength)
+		hraSubspace.Set(ctx, hra.KeyTextRecordFee, hraDefaults.TextRecordFee)
+		hraSubspace.Set(ctx, hra.KeyNameInfoPricingSchedule, hraDefaults.NameInfoPricingSchedule)
+		hraSubspace.Set(ctx, hra.KeyOfferMaxDuration, hraDefaults.OfferMaxDuration)
+		hraSubspace.Set(ctx, hra.KeyNameHistoryRetention, hraDefaults.NameHistoryRetention)
+		hraSubspace.Set(ctx, hra.KeyTransferDelay, hraDefaults.TransferDelay)
+
+		// Build the for sale index for names priced before the upgrade
+		app.hraKeeper.IndexNamesForSale(ctx)
+
+		treasuryDefaults := treasury.DefaultParams()
+		treasurySubspace := app.subspaces[treasury.ModuleName]
+		treasurySubspace.Set(ctx, treasury.KeyDisbursementApprovalThreshold, treasuryDefaults.DisbursementApprovalThreshold)
+		treasurySubspace.Set(ctx, treasury.KeyDisbursementLimitWindow, treasuryDefaults.DisbursementLimitWindow)
+		treasurySubspace.Set(ctx, treasury.KeyOperatorDisbursementLimit, treasuryDefaults.OperatorDisbursementLimit)
+		treasurySubspace.Set(ctx, treasury.KeyTreasuryDisbursementLimit, treasuryDefaults.TreasuryDisbursementLimit)
+		treasurySubspace.Set(ctx, treasury.KeyBondingCurve, treasuryDefaults.BondingCurve)
+		treasurySubspace.Set(ctx, treasury.KeyMaxLimitOrderDuration, treasuryDefaults.MaxLimitOrderDuration)
+		treasurySubspace.Set(ctx, treasury.KeySwapAttestationThreshold, treasuryDefaults.SwapAttestationThreshold)
+		treasurySubspace.Set(ctx, treasury.KeyDisbursementRejectionThreshold, treasuryDefaults.DisbursementRejectionThreshold)
+		treasurySubspace.Set(ctx, treasury.KeyLedgerRetention, treasuryDefaults.LedgerRetention)
+	})
+
 	// create evidence keeper with evidence router
 	evidenceKeeper := evidence.NewKeeper(
 		app.cdc, keys[evidence.StoreKey], app.subspaces[evidence.ModuleName], &stakingKeeper, app.slashingKeeper,
@@ -412,7 +464,7 @@ func NewAnathaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		upgrade.NewAppModule(app.upgradeKeeper),
 		evidence.NewAppModule(app.evidenceKeeper),
 		hra.NewAppModule(app.hraKeeper, app.bankKeeper, app.accountKeeper, app.supplyKeeper),
-		treasury.NewAppModule(app.treasuryKeeper, app.accountKeeper, app.supplyKeeper, app.bankKeeper),
+		treasury.NewAppModule(app.treasuryKeeper, app.orderBookKeeper, app.accountKeeper, app.supplyKeeper, app.bankKeeper),
 		distribution.NewAppModule(app.distributionKeeper, app.supplyKeeper),
 		fee.NewAppModule(app.feeKeeper),
 	)
@@ -423,6 +475,7 @@ func NewAnathaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	// Sets the order of Genesis - Order matters, genutil is to always come last
 	// NOTE: The genutils moodule must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
+	// The crisis module asserts the registered invariants on init, so it must come after the modules they check.
 	app.mm.SetOrderInitGenesis(
 		distribution.ModuleName,
 		staking.ModuleName,
@@ -433,10 +486,10 @@ func NewAnathaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		mint.ModuleName,
 		hra.ModuleName,
 		supply.ModuleName,
-		crisis.ModuleName,
 		evidence.ModuleName,
 		treasury.ModuleName,
 		fee.ModuleName,
+		crisis.ModuleName,
 		genutil.ModuleName,
 	)
 
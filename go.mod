@@ -5,6 +5,7 @@ go 1.13
 require (
 	github.com/anathatech/cosmosd v0.2.2
 	github.com/btcsuite/btcd v0.0.0-20190523000118-16327141da8c // indirect
+	github.com/btcsuite/btcutil v0.0.0-20190425235716-9e5f4b9a998d
 	github.com/cosmos/cosmos-sdk v0.38.4
 	github.com/gorilla/mux v1.7.3
 	github.com/onsi/ginkgo v1.8.0 // indirect
@@ -19,6 +20,7 @@ require (
 	github.com/tendermint/go-amino v0.15.1
 	github.com/tendermint/tendermint v0.33.3
 	github.com/tendermint/tm-db v0.5.0
+	golang.org/x/crypto v0.0.0-20191206172530-e9b2fee46413
 	gopkg.in/yaml.v2 v2.2.8
 )
 
@@ -60,10 +60,20 @@ func (d FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next
 				credits = d.hraKeeper.AddressCredits(ctx)
 			}
 
-			msgFee = msgFee.Add(d.hraKeeper.NameInfoRegistrationFee(ctx)...)
+			fee, err := d.hraKeeper.GetRegistrationFee(ctx, msg.Name, msg.GetPeriods())
+			if err != nil {
+				return ctx, err
+			}
+
+			msgFee = msgFee.Add(fee...)
 
 		case hra.MsgRenewName:
-			msgFee = msgFee.Add(d.hraKeeper.NameInfoRenewalFee(ctx)...)
+			fee, err := d.hraKeeper.GetRenewalFee(ctx, msg.Name, msg.GetPeriods())
+			if err != nil {
+				return ctx, err
+			}
+
+			msgFee = msgFee.Add(fee...)
 
 		case hra.MsgBuyName:
 			fee, err := d.hraKeeper.GetPrice(ctx, msg.Name)
@@ -78,13 +88,56 @@ func (d FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next
 
 			msgFee = msgFee.Add(fee...)
 
+		case hra.MsgPlaceBid:
+			msgFee = msgFee.Add(msg.Amount...)
+
+		case hra.MsgCommitBid:
+			msgFee = msgFee.Add(msg.Deposit...)
+
+		case hra.MsgPlaceOffer:
+			msgFee = msgFee.Add(msg.Amount...)
+
 		case hra.MsgRegisterAddress:
+			// only new records are charged, updating a record is free
+			if d.hraKeeper.HasAddress(ctx, msg.Owner, msg.BlockchainId, msg.Index) {
+				break
+			}
+
 			if credits.LTE(sdk.ZeroInt()) {
 				msgFee = msgFee.Add(d.hraKeeper.AddressRegistrationFee(ctx)...)
 			}
 
 			credits = credits.Sub(sdk.OneInt())
 
+		case hra.MsgSetAddresses:
+			for _, address := range msg.Addresses {
+				if d.hraKeeper.HasAddress(ctx, msg.Owner, address.BlockchainId, address.Index) {
+					continue
+				}
+
+				if credits.LTE(sdk.ZeroInt()) {
+					msgFee = msgFee.Add(d.hraKeeper.AddressRegistrationFee(ctx)...)
+				}
+
+				credits = credits.Sub(sdk.OneInt())
+			}
+
+		case hra.MsgRegisterSubname:
+			msgFee = msgFee.Add(d.hraKeeper.SubnameRegistrationFee(ctx)...)
+
+		case hra.MsgRegisterSubnameAddress:
+			msgFee = msgFee.Add(d.hraKeeper.AddressRegistrationFee(ctx)...)
+
+		case hra.MsgOperatorRegisterAddress:
+			// operators set records of the name itself, which are not covered by the owner's credits
+			msgFee = msgFee.Add(d.hraKeeper.AddressRegistrationFee(ctx)...)
+
+		case hra.MsgSetTextRecord:
+			// only new keys are charged, updating a record is free
+			if ! d.hraKeeper.HasTextRecord(ctx, msg.Name, msg.Key) {
+				msgFee = msgFee.Add(d.hraKeeper.TextRecordFee(ctx)...)
+			}
+
 		case hra.MsgTransferName:
 			if namesOwnedCount == 1 {
 				namesOwnedCount--
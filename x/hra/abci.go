@@ -4,23 +4,93 @@ import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
 )
 
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 }
 
 func EndBlocker(ctx sdk.Context, k Keeper) {
-	k.IterateExpiredNameInfoQueue(ctx, ctx.BlockTime(), func(name string) (stop bool) {
+	k.IterateAuctionQueue(ctx, ctx.BlockTime(), func(name string) (stop bool) {
+		auction, found := k.GetAuction(ctx, name)
+		if ! found {
+			panic(fmt.Sprintf("auction %s does not exist", name))
+		}
+
+		runQueueItem(ctx, k, types.EventTypeSettleAuction, name, nil, func(cacheCtx sdk.Context) error {
+			return k.SettleAuction(cacheCtx, auction)
+		})
+
+		return false
+	})
+
+	k.IterateOfferQueue(ctx, ctx.BlockTime(), func(name string, bidder sdk.AccAddress) (stop bool) {
+		offer, found := k.GetOffer(ctx, name, bidder)
+		if ! found {
+			panic(fmt.Sprintf("offer on %s by %s does not exist", name, bidder))
+		}
+
+		runQueueItem(ctx, k, types.EventTypeExpireOffer, name, bidder, func(cacheCtx sdk.Context) error {
+			return k.ExpireOffer(cacheCtx, offer)
+		})
+
+		return false
+	})
+
+	k.IteratePendingTransferQueue(ctx, ctx.BlockTime(), func(name string) (stop bool) {
+		transfer, found := k.GetPendingTransfer(ctx, name)
+		if ! found {
+			panic(fmt.Sprintf("pending transfer of %s does not exist", name))
+		}
+
+		runQueueItem(ctx, k, types.EventTypeCompleteTransfer, name, nil, func(cacheCtx sdk.Context) error {
+			return k.CompletePendingTransfer(cacheCtx, transfer)
+		})
+
+		return false
+	})
+
+	// names are released only after both the grace and the redemption period have passed
+	releaseTime := ctx.BlockTime().Add(-(k.NameInfoGracePeriod(ctx) + k.NameInfoRedemptionPeriod(ctx)))
+
+	k.IterateExpiredNameInfoQueue(ctx, releaseTime, func(name string) (stop bool) {
 		nameInfo, found := k.GetNameInfo(ctx, name)
 		if ! found {
 			panic(fmt.Sprintf("name info %s does not exist", name))
 		}
 
-		err := k.DeleteExpiredNameInfo(ctx, nameInfo)
-		if err != nil {
-			panic("error deleting expired name info")
-		}
+		runQueueItem(ctx, k, types.EventTypeExpiredName, name, nil, func(cacheCtx sdk.Context) error {
+			return k.DeleteExpiredNameInfo(cacheCtx, nameInfo)
+		})
 
 		return false
 	})
-}
\ No newline at end of file
+}
+
+// runQueueItem processes a queue item in a cached context so a failing item leaves no partial changes behind. A failed
+// item is moved from its queue to the failed set, where it waits to be retried with MsgRetryFailedQueueItem.
+func runQueueItem(ctx sdk.Context, k Keeper, action string, name string, bidder sdk.AccAddress, process func(cacheCtx sdk.Context) error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	err := process(cacheCtx)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("%s of %s failed: %s", action, name, err.Error()))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEndBlockFailure,
+				sdk.NewAttribute(types.AttributeKeyAction, action),
+				sdk.NewAttribute(types.AttributeKeyName, name),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			),
+		)
+
+		k.FailQueueItem(ctx, types.NewFailedQueueItem(action, name, bidder, err.Error(), ctx.BlockTime()))
+
+		return
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
@@ -12,6 +12,13 @@ const (
 	DefaultParamspace = types.DefaultParamspace
 	QuerierRoute      = types.QuerierRoute
 	NameConstraintBlock = types.NameConstraintBlock
+	AuctionEscrowModuleName = types.AuctionEscrowModuleName
+	OfferEscrowModuleName = types.OfferEscrowModuleName
+	AuctionTypeEnglish = types.AuctionTypeEnglish
+	AuctionTypeSealed = types.AuctionTypeSealed
+	NameStatusActive = types.NameStatusActive
+	NameStatusGrace = types.NameStatusGrace
+	NameStatusRedemption = types.NameStatusRedemption
 )
 
 var (
@@ -20,6 +27,7 @@ var (
 	RegisterCodec       = types.RegisterCodec
 	NewGenesisState     = types.NewGenesisState
 	DefaultGenesisState = types.DefaultGenesisState
+	DefaultParams       = types.DefaultParams
 	ValidateGenesis     = types.ValidateGenesis
 	NewMultiNameHooks 	= types.NewMultiNameHooks
 	NewMsgRegisterName  = types.NewMsgRegisterName
@@ -30,10 +38,55 @@ var (
 	NewMsgTransferName	= types.NewMsgTransferName
 	NewMsgRegisterAddress = types.NewMsgRegisterAddress
 	NewMsgRemoveAddress = types.NewMsgRemoveAddress
+	NewMsgOpenAuction = types.NewMsgOpenAuction
+	NewMsgPlaceBid = types.NewMsgPlaceBid
+	NewMsgCommitBid = types.NewMsgCommitBid
+	NewMsgRevealBid = types.NewMsgRevealBid
+	GetSealedBidCommitment = types.GetSealedBidCommitment
+	NewMsgRegisterSubname = types.NewMsgRegisterSubname
+	NewMsgRegisterSubnameAddress = types.NewMsgRegisterSubnameAddress
+	NewMsgRemoveSubnameAddress = types.NewMsgRemoveSubnameAddress
+	NewMsgSetPrimaryName = types.NewMsgSetPrimaryName
+	NewMsgSetTextRecord = types.NewMsgSetTextRecord
+	NewMsgRemoveTextRecord = types.NewMsgRemoveTextRecord
+	NewMsgApproveOperator = types.NewMsgApproveOperator
+	NewMsgRevokeOperator = types.NewMsgRevokeOperator
+	NewMsgOperatorRegisterAddress = types.NewMsgOperatorRegisterAddress
+	NewMsgOperatorRemoveAddress = types.NewMsgOperatorRemoveAddress
+	NewMsgPlaceOffer = types.NewMsgPlaceOffer
+	NewMsgAcceptOffer = types.NewMsgAcceptOffer
+	NewMsgWithdrawOffer = types.NewMsgWithdrawOffer
+	NewMsgSetAddresses = types.NewMsgSetAddresses
+	NewMsgStartTransfer = types.NewMsgStartTransfer
+	NewMsgCancelTransfer = types.NewMsgCancelTransfer
+	NewMsgRetryFailedQueueItem = types.NewMsgRetryFailedQueueItem
+	NewFailedQueueItem = types.NewFailedQueueItem
+	NewBlockchainAddressInfo = types.NewBlockchainAddressInfo
+	NewPricingTier = types.NewPricingTier
+	NewSetPricingScheduleProposal = types.NewSetPricingScheduleProposal
+	NewReserveNamesProposal = types.NewReserveNamesProposal
+	NewReleaseNamesProposal = types.NewReleaseNamesProposal
+	NewRevokeNameProposal = types.NewRevokeNameProposal
+	NewQueryNamesParams = types.NewQueryNamesParams
+	NewQueryExpiringNamesParams = types.NewQueryExpiringNamesParams
+	NewQueryNameHistoryParams = types.NewQueryNameHistoryParams
+	NewSetNameHistoryRetentionProposal = types.NewSetNameHistoryRetentionProposal
 
 	ModuleCdc     = types.ModuleCdc
 
 	KeyNameInfoDuration = types.KeyNameInfoDuration
+	KeyNameInfoGracePeriod = types.KeyNameInfoGracePeriod
+	KeyNameInfoRedemptionPeriod = types.KeyNameInfoRedemptionPeriod
+	KeyNameInfoRedemptionFee = types.KeyNameInfoRedemptionFee
+	KeyAuctionRevealDuration = types.KeyAuctionRevealDuration
+	KeyTextRecordMaxCount = types.KeyTextRecordMaxCount
+	KeyTextRecordMaxValueLength = types.KeyTextRecordMaxValueLength
+	KeyTextRecordFee = types.KeyTextRecordFee
+	KeySubnameRegistrationFee = types.KeySubnameRegistrationFee
+	KeyOfferMaxDuration = types.KeyOfferMaxDuration
+	KeyNameInfoPricingSchedule = types.KeyNameInfoPricingSchedule
+	KeyTransferDelay = types.KeyTransferDelay
+	KeyNameHistoryRetention = types.KeyNameHistoryRetention
 
 	ErrNameNotRegistered = types.ErrNameNotRegistered
 )
@@ -54,4 +107,34 @@ type (
 	MsgRegisterAddress = types.MsgRegisterAddress
 	MsgRemoveAddress = types.MsgRemoveAddress
 	MsgRemoveAllAddresses = types.MsgRemoveAllAddresses
+	MsgOpenAuction = types.MsgOpenAuction
+	MsgPlaceBid = types.MsgPlaceBid
+	MsgCommitBid = types.MsgCommitBid
+	MsgRevealBid = types.MsgRevealBid
+	MsgRegisterSubname = types.MsgRegisterSubname
+	MsgRegisterSubnameAddress = types.MsgRegisterSubnameAddress
+	MsgRemoveSubnameAddress = types.MsgRemoveSubnameAddress
+	MsgSetPrimaryName = types.MsgSetPrimaryName
+	MsgSetTextRecord = types.MsgSetTextRecord
+	MsgRemoveTextRecord = types.MsgRemoveTextRecord
+	MsgApproveOperator = types.MsgApproveOperator
+	MsgRevokeOperator = types.MsgRevokeOperator
+	MsgOperatorRegisterAddress = types.MsgOperatorRegisterAddress
+	MsgOperatorRemoveAddress = types.MsgOperatorRemoveAddress
+	MsgPlaceOffer = types.MsgPlaceOffer
+	MsgAcceptOffer = types.MsgAcceptOffer
+	MsgWithdrawOffer = types.MsgWithdrawOffer
+	MsgSetAddresses = types.MsgSetAddresses
+	MsgStartTransfer = types.MsgStartTransfer
+	MsgCancelTransfer = types.MsgCancelTransfer
+	MsgRetryFailedQueueItem = types.MsgRetryFailedQueueItem
+	BlockchainAddressInfo = types.BlockchainAddressInfo
+	Auction = types.Auction
+	SealedBid = types.SealedBid
+	Offer = types.Offer
+	NameHistoryEntry = types.NameHistoryEntry
+	PendingTransfer = types.PendingTransfer
+	FailedQueueItem = types.FailedQueueItem
+	PricingTier = types.PricingTier
+	PricingSchedule = types.PricingSchedule
 )
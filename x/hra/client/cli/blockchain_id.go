@@ -19,6 +19,16 @@ func GetCmdSubmitRegisterBlockchainIdProposal(cdc *codec.Codec) *cobra.Command {
 		Use:   "register-blockchain-id [proposal-file]",
 		Args:  cobra.ExactArgs(1),
 		Short: "Submit a proposal to register a blockchain id",
+		Long: `Submit a proposal to register a blockchain id. The proposal file may name the address validator
+to use for the blockchain id, one of: length, bitcoin, litecoin, ethereum, cosmos, ripple.
+
+Example proposal file:
+{
+  "title": "Register Bitcoin",
+  "description": "Allow bitcoin addresses to be attached to names",
+  "blockchain_id": "btc",
+  "address_validator": "bitcoin"
+}`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -31,7 +41,7 @@ func GetCmdSubmitRegisterBlockchainIdProposal(cdc *codec.Codec) *cobra.Command {
 
 			from := cliCtx.GetFromAddress()
 
-			content := types.NewRegisterBlockchainIdProposal(proposal.Title, proposal.Description, proposal.BlockchainId)
+			content := types.NewRegisterBlockchainIdProposal(proposal.Title, proposal.Description, proposal.BlockchainId, proposal.AddressValidator)
 
 			msg := govtypes.NewMsgSubmitProposal(content, from)
 			if err := msg.ValidateBasic(); err != nil {
@@ -74,4 +84,47 @@ func GetCmdSubmitRemoveBlockchainIdProposal(cdc *codec.Codec) *cobra.Command {
 	}
 
 	return cmd
-}
\ No newline at end of file
+}
+func GetCmdSubmitSetPricingScheduleProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pricing-schedule [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the name registration pricing schedule",
+		Long: `Submit a proposal to set the name registration pricing schedule. Tiers must be ordered by increasing
+max length, names longer than the last tier pay the flat registration fee. Purely numeric names are charged
+the numeric multiplier times the tier fee, a zero multiplier disables the premium.
+
+Example proposal file:
+{
+  "title": "Short name pricing",
+  "description": "Raise the price of three and four character names",
+  "pricing_schedule": [
+    {"max_length": "3", "fee": [{"denom": "pin", "amount": "1000000000"}], "numeric_multiplier": "2.0"},
+    {"max_length": "4", "fee": [{"denom": "pin", "amount": "500000000"}], "numeric_multiplier": "0"}
+  ]
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := govutils.ParsePricingScheduleProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+
+			content := types.NewSetPricingScheduleProposal(proposal.Title, proposal.Description, proposal.PricingSchedule)
+
+			msg := govtypes.NewMsgSubmitProposal(content, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,174 @@
+package cli
+
+
+import (
+	"bufio"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govtypes "github.com/anathatech/project-anatha/x/governance"
+	govutils "github.com/anathatech/project-anatha/x/hra/client/utils"
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+	"github.com/spf13/cobra"
+)
+
+func GetCmdSubmitReserveNamesProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve-names [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to reserve names",
+		Long: `Submit a proposal to reserve names. Reserved names cannot be registered until they are released, names
+which are already registered stay with their owner until they expire or are revoked.
+
+Example proposal file:
+{
+  "title": "Reserve system names",
+  "description": "Keep system names from being registered",
+  "names": ["anatha", "treasury"]
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := govutils.ParseNamesProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+
+			content := types.NewReserveNamesProposal(proposal.Title, proposal.Description, proposal.Names)
+
+			msg := govtypes.NewMsgSubmitProposal(content, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func GetCmdSubmitReleaseNamesProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-names [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to release reserved names",
+		Long: `Submit a proposal to release reserved names so they can be registered again.
+
+Example proposal file:
+{
+  "title": "Release names",
+  "description": "Allow these names to be registered",
+  "names": ["treasury"]
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := govutils.ParseNamesProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+
+			content := types.NewReleaseNamesProposal(proposal.Title, proposal.Description, proposal.Names)
+
+			msg := govtypes.NewMsgSubmitProposal(content, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func GetCmdSubmitRevokeNameProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-name [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to revoke a name",
+		Long: `Submit a proposal to revoke a name from its owner. Open auctions of the name are cancelled and all bids refunded.
+The name is reserved afterwards, a release-names proposal makes it available for registration again.
+
+Example proposal file:
+{
+  "title": "Revoke name",
+  "description": "The name impersonates the foundation",
+  "name": "anatha-support"
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := govutils.ParseRevokeNameProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+
+			content := types.NewRevokeNameProposal(proposal.Title, proposal.Description, proposal.Name)
+
+			msg := govtypes.NewMsgSubmitProposal(content, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func GetCmdSubmitSetNameHistoryRetentionProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-name-history-retention [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set how many history entries are kept per name",
+		Long: `Submit a proposal to set how many history entries are kept per name. When the retention is lowered, the
+oldest entries of a name are pruned the next time the name changes.
+
+Example proposal file:
+{
+  "title": "Name history retention",
+  "description": "Keep the last 50 changes of every name",
+  "retention": "50"
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := govutils.ParseNameHistoryRetentionProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+
+			content := types.NewSetNameHistoryRetentionProposal(proposal.Title, proposal.Description, proposal.Retention)
+
+			msg := govtypes.NewMsgSubmitProposal(content, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
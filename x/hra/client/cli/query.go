@@ -7,6 +7,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -32,6 +34,26 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdGetAddressCredits(queryRoute, cdc),
 			GetCmdGetBlockchainAddresses(queryRoute, cdc),
 			GetCmdQueryParams(queryRoute, cdc),
+			GetCmdQueryAuction(queryRoute, cdc),
+			GetCmdQueryAuctions(queryRoute, cdc),
+			GetCmdQuerySealedBids(queryRoute, cdc),
+			GetCmdQuerySubnames(queryRoute, cdc),
+			GetCmdReverseResolve(queryRoute, cdc),
+			GetCmdQueryTextRecord(queryRoute, cdc),
+			GetCmdQueryTextRecords(queryRoute, cdc),
+			GetCmdQueryOperators(queryRoute, cdc),
+			GetCmdQueryPriceQuote(queryRoute, cdc),
+			GetCmdQueryReservedNames(queryRoute, cdc),
+			GetCmdQueryOffers(queryRoute, cdc),
+			GetCmdQueryBidderOffers(queryRoute, cdc),
+			GetCmdQueryAllNames(queryRoute, cdc),
+			GetCmdQueryNamesByPrefix(queryRoute, cdc),
+			GetCmdQueryNamesForSale(queryRoute, cdc),
+			GetCmdQueryExpiringNames(queryRoute, cdc),
+			GetCmdQueryNameHistory(queryRoute, cdc),
+			GetCmdQueryPendingTransfer(queryRoute, cdc),
+			GetCmdQueryPendingTransfers(queryRoute, cdc),
+			GetCmdQueryFailedQueueItems(queryRoute, cdc),
 		)...,
 	)
 
@@ -201,6 +223,69 @@ func GetCmdGetBlockchainAddresses(queryRoute string, cdc *codec.Codec) *cobra.Co
 	}
 }
 
+func GetCmdQueryAuction(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auction [name]",
+		Short: "Query the auction of a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/auction/%s", queryRoute, name), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve auction - %s \n", name)
+				return nil
+			}
+
+			var out types.Auction
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryAuctions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auctions",
+		Short: "Query all open auctions",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/auctions", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResAuctions
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQuerySealedBids(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sealed-bids [name]",
+		Short: "Query the sealed bids committed to the auction of a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/sealed-bids/%s", queryRoute, name), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResSealedBids
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 func GetModuleAccountCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "module [name]",
@@ -247,4 +332,414 @@ func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return cliCtx.PrintOutput(params)
 		},
 	}
-}
\ No newline at end of file
+}
+func GetCmdQuerySubnames(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "subnames [name]",
+		Short: "Query the subnames of a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/subnames/%s", queryRoute, name), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve subnames - %s \n", name)
+				return nil
+			}
+
+			var out types.QueryResNames
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdReverseResolve(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reverse [address]",
+		Short: "Query the primary name of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			address := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/reverse/%s", queryRoute, address), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve primary name - %s \n", address)
+				return nil
+			}
+
+			var out string
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryTextRecord(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "text-record [name] [key]",
+		Short: "Query a text record of a name",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/text-record/%s/%s", queryRoute, args[0], args[1]), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve text record - %s %s \n", args[0], args[1])
+				return nil
+			}
+
+			var out types.TextRecord
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryTextRecords(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "text-records [name]",
+		Short: "Query all text records of a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/text-records/%s", queryRoute, name), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve text records - %s \n", name)
+				return nil
+			}
+
+			var out types.QueryResTextRecords
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryOperators(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "operators [name]",
+		Short: "Query the approved operators of a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/operators/%s", queryRoute, name), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve operators - %s \n", name)
+				return nil
+			}
+
+			var out types.QueryResOperators
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryPriceQuote(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "price-quote [name] [periods]",
+		Short: "Query the registration fee of a name for a number of periods, defaults to 1 period",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			route := fmt.Sprintf("custom/%s/price-quote/%s", queryRoute, name)
+			if len(args) > 1 {
+				route = fmt.Sprintf("%s/%s", route, args[1])
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResPriceQuote
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryReservedNames(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reserved-names",
+		Short: "Query the names reserved through governance",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/reserved-names", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResNames
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryOffers(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "offers [name]",
+		Short: "Query the open offers on a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/offers/%s", queryRoute, name), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve offers - %s \n", name)
+				return nil
+			}
+
+			var out types.QueryResOffers
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryBidderOffers(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bidder-offers [address]",
+		Short: "Query the open offers placed by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			address := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/bidder-offers/%s", queryRoute, address), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve offers - %s \n", address)
+				return nil
+			}
+
+			var out types.QueryResOffers
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryAllNames(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-names",
+		Short: "Query all registered names, paginated",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryNamesParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), "")
+
+			return queryNamesPage(cliCtx, cdc, fmt.Sprintf("custom/%s/all-names", queryRoute), params)
+		},
+	}
+
+	addPaginationFlags(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryNamesByPrefix(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search-names [prefix]",
+		Short: "Query the names starting with a prefix, paginated",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryNamesParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), args[0])
+
+			return queryNamesPage(cliCtx, cdc, fmt.Sprintf("custom/%s/names-by-prefix", queryRoute), params)
+		},
+	}
+
+	addPaginationFlags(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryNamesForSale(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "names-for-sale [prefix]",
+		Short: "Query the names with a price set, optionally starting with a prefix, paginated",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			prefix := ""
+			if len(args) == 1 {
+				prefix = args[0]
+			}
+
+			params := types.NewQueryNamesParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), prefix)
+
+			return queryNamesPage(cliCtx, cdc, fmt.Sprintf("custom/%s/names-for-sale", queryRoute), params)
+		},
+	}
+
+	addPaginationFlags(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryExpiringNames(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring-names [from] [to]",
+		Short: "Query the names expiring within a time window, times are in RFC3339 format, paginated",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			from, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				return err
+			}
+
+			to, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryExpiringNamesParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), from, to)
+
+			return queryNamesPage(cliCtx, cdc, fmt.Sprintf("custom/%s/expiring-names", queryRoute), params)
+		},
+	}
+
+	addPaginationFlags(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryNameHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "name-history [name]",
+		Short: "Query the history of a name, most recent first, paginated",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryNameHistoryParams(args[0], viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/name-history", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResNameHistory
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	addPaginationFlags(cmd)
+
+	return cmd
+}
+
+func addPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page to query for")
+	cmd.Flags().Int(flags.FlagLimit, types.QueryDefaultLimit, "pagination limit to query for")
+}
+
+func queryNamesPage(cliCtx context.CLIContext, cdc *codec.Codec, route string, params interface{}) error {
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	var out types.QueryResNamesPage
+	cdc.MustUnmarshalJSON(res, &out)
+	return cliCtx.PrintOutput(out)
+}
+
+func GetCmdQueryPendingTransfer(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-transfer [name]",
+		Short: "Query the pending transfer of a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/pending-transfer/%s", queryRoute, name), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve pending transfer - %s \n", name)
+				return nil
+			}
+
+			var out types.PendingTransfer
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryPendingTransfers(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-transfers [address]",
+		Short: "Query all pending transfers, or only those sent or received by an address",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/pending-transfers", queryRoute)
+			if len(args) > 0 {
+				route = fmt.Sprintf("%s/%s", route, args[0])
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf("Could not resolve pending transfers \n")
+				return nil
+			}
+
+			var out types.QueryResPendingTransfers
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryFailedQueueItems(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "failed-queue-items",
+		Short: "Query the auctions, offers, transfers and expired names the end blocker failed to process",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/failed-queue-items", queryRoute), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve failed queue items \n")
+				return nil
+			}
+
+			var out types.QueryResFailedQueueItems
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
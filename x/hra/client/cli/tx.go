@@ -10,8 +10,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	denom "github.com/anathatech/project-anatha/utils"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"io/ioutil"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -20,6 +23,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	FlagPeriods = "periods"
+)
+
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	hraTxCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -36,17 +43,38 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdDeleteName(cdc),
 		GetCmdBuyName(cdc),
 		GetCmdTransferName(cdc),
+		GetCmdStartTransfer(cdc),
+		GetCmdCancelTransfer(cdc),
 		GetCmdRegisterAddress(cdc),
 		GetCmdRegisterAddressBatch(cdc),
+		GetCmdSetAddresses(cdc),
 		GetCmdRemoveAddress(cdc),
 		GetCmdRemoveAllAddresses(cdc),
+		GetCmdOpenAuction(cdc),
+		GetCmdPlaceBid(cdc),
+		GetCmdCommitBid(cdc),
+		GetCmdRevealBid(cdc),
+		GetCmdRegisterSubname(cdc),
+		GetCmdRegisterSubnameAddress(cdc),
+		GetCmdRemoveSubnameAddress(cdc),
+		GetCmdSetPrimaryName(cdc),
+		GetCmdSetTextRecord(cdc),
+		GetCmdRemoveTextRecord(cdc),
+		GetCmdApproveOperator(cdc),
+		GetCmdRevokeOperator(cdc),
+		GetCmdOperatorRegisterAddress(cdc),
+		GetCmdOperatorRemoveAddress(cdc),
+		GetCmdPlaceOffer(cdc),
+		GetCmdAcceptOffer(cdc),
+		GetCmdWithdrawOffer(cdc),
+		GetCmdRetryFailedQueueItem(cdc),
 	)...)
 
 	return hraTxCmd
 }
 
 func GetCmdRegisterName(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 
 		Use:   "register [name] [addresses-file]",
 		Short: "register a new hra",
@@ -77,7 +105,7 @@ func GetCmdRegisterName(cdc *codec.Codec) *cobra.Command {
 
 			}
 
-			msg := types.NewMsgRegisterName(args[0], cliCtx.GetFromAddress())
+			msg := types.NewMsgRegisterName(args[0], cliCtx.GetFromAddress(), viper.GetUint64(FlagPeriods))
 			err := msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -104,6 +132,10 @@ func GetCmdRegisterName(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, msgs)
 		},
 	}
+
+	cmd.Flags().Uint64(FlagPeriods, 1, "number of periods to register the hra for")
+
+	return cmd
 }
 
 func closeFile(f *os.File) {
@@ -114,7 +146,7 @@ func closeFile(f *os.File) {
 }
 
 func GetCmdRenewName(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "renew [name]",
 		Short: "renew a hra",
 		Args:  cobra.ExactArgs(1),
@@ -124,7 +156,7 @@ func GetCmdRenewName(cdc *codec.Codec) *cobra.Command {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 
-			msg := types.NewMsgRenewName(args[0], cliCtx.GetFromAddress())
+			msg := types.NewMsgRenewName(args[0], cliCtx.GetFromAddress(), viper.GetUint64(FlagPeriods))
 			err := msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -133,6 +165,10 @@ func GetCmdRenewName(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().Uint64(FlagPeriods, 1, "number of periods to renew the hra for")
+
+	return cmd
 }
 
 func GetCmdSetPrice(cdc *codec.Codec) *cobra.Command {
@@ -233,6 +269,55 @@ func GetCmdTransferName(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdStartTransfer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "start-transfer [name] [new_owner]",
+		Short: "start a delayed transfer of a hra, the hra is locked and the transfer can be cancelled until it completes",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStartTransfer(args[0], cliCtx.GetFromAddress(), newOwner)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCancelTransfer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-transfer [name]",
+		Short: "cancel a pending transfer of a hra",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgCancelTransfer(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 func GetCmdRegisterAddress(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "register-address [blockchanId] [index] [blockchainAddress]",
@@ -304,6 +389,54 @@ func GetCmdRegisterAddressBatch(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdSetAddresses(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-addresses [addresses-file]",
+		Short: "atomically register and remove a batch of blockchain addresses",
+		Long: strings.TrimSpace(`Atomically register and remove a batch of blockchain addresses. The file has the following format:
+
+{
+  "addresses": [{"blockchain_id": "bitcoin", "index": "0", "blockchain_address": "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}],
+  "removals": [{"blockchain_id": "ethereum", "index": "1"}]
+}
+`),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			var batch struct {
+				Addresses []types.BlockchainAddressInfo `json:"addresses"`
+				Removals  []types.BlockchainAddressInfo `json:"removals"`
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+
+			defer closeFile(file)
+
+			bytes, _ := ioutil.ReadAll(file)
+
+			err = json.Unmarshal(bytes, &batch)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAddresses(cliCtx.GetFromAddress(), batch.Addresses, batch.Removals)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 func GetCmdRemoveAddress(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "remove-address [blockchanId] [index]",
@@ -346,4 +479,470 @@ func GetCmdRemoveAllAddresses(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
-}
\ No newline at end of file
+}
+
+func GetCmdOpenAuction(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "open-auction [name] [english|sealed] [reserve-price] [duration]",
+		Short: "open an auction for a hra",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			reservePrice, err := denom.ParseAndConvertCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOpenAuction(args[0], cliCtx.GetFromAddress(), args[1], reservePrice, duration)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdPlaceBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bid [name] [amount]",
+		Short: "place a bid in an english auction",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := denom.ParseAndConvertCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBid(args[0], cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCommitBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "commit-bid [name] [amount] [salt] [deposit]",
+		Short: "commit a sealed bid; only the commitment hash and the deposit are broadcast",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := denom.ParseAndConvertCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := denom.ParseAndConvertCoins(args[3])
+			if err != nil {
+				return err
+			}
+
+			commitment := types.GetSealedBidCommitment(args[0], cliCtx.GetFromAddress(), amount, args[2])
+
+			msg := types.NewMsgCommitBid(args[0], cliCtx.GetFromAddress(), commitment, deposit)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRevealBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reveal-bid [name] [amount] [salt]",
+		Short: "reveal a previously committed sealed bid",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := denom.ParseAndConvertCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealBid(args[0], cliCtx.GetFromAddress(), amount, args[2])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+func GetCmdRegisterSubname(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "register-subname [name] [subname_owner]",
+		Short: "register a subname under a hra you own, e.g. wallet.alice",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			subnameOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterSubname(args[0], cliCtx.GetFromAddress(), subnameOwner)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRegisterSubnameAddress(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "register-subname-address [name] [blockchainId] [index] [blockchainAddress]",
+		Short: "register a new blockchain address for a subname",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRegisterSubnameAddress(args[0], cliCtx.GetFromAddress(), args[1], args[2], args[3])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRemoveSubnameAddress(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-subname-address [name] [blockchainId] [index]",
+		Short: "remove a blockchain address of a subname",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRemoveSubnameAddress(args[0], cliCtx.GetFromAddress(), args[1], args[2])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdSetPrimaryName(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-primary-name [name]",
+		Short: "set the hra your address reverse resolves to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgSetPrimaryName(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdSetTextRecord(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-text-record [name] [key] [value]",
+		Short: "set a text record such as avatar, email or url on a hra",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgSetTextRecord(args[0], cliCtx.GetFromAddress(), args[1], args[2])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRemoveTextRecord(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-text-record [name] [key]",
+		Short: "remove a text record from a hra",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRemoveTextRecord(args[0], cliCtx.GetFromAddress(), args[1])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdApproveOperator(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "approve-operator [name] [operator]",
+		Short: "allow an address to manage the blockchain addresses, renewal and price of a hra",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			operator, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveOperator(args[0], cliCtx.GetFromAddress(), operator)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRevokeOperator(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-operator [name] [operator]",
+		Short: "revoke an operator of a hra",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			operator, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeOperator(args[0], cliCtx.GetFromAddress(), operator)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdOperatorRegisterAddress(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "operator-register-address [name] [blockchainId] [index] [blockchainAddress]",
+		Short: "register a blockchain address for a hra as its operator",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgOperatorRegisterAddress(args[0], cliCtx.GetFromAddress(), args[1], args[2], args[3])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdOperatorRemoveAddress(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "operator-remove-address [name] [blockchainId] [index]",
+		Short: "remove a blockchain address of a hra as its operator",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgOperatorRemoveAddress(args[0], cliCtx.GetFromAddress(), args[1], args[2])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdPlaceOffer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "place-offer [name] [amount] [duration]",
+		Short: "offer to buy a hra, the amount is held in escrow until the offer is accepted, withdrawn or expires",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := denom.ParseAndConvertCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceOffer(args[0], cliCtx.GetFromAddress(), amount, duration)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdAcceptOffer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "accept-offer [name] [bidder]",
+		Short: "accept an offer, transferring the hra to the bidder",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			bidder, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptOffer(args[0], cliCtx.GetFromAddress(), bidder)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdWithdrawOffer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-offer [name]",
+		Short: "withdraw an offer and get the escrowed amount back",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgWithdrawOffer(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRetryFailedQueueItem(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "retry-failed-queue-item [action] [name] [bidder]",
+		Short: "process an auction, offer, transfer or expired name the end blocker failed to process, the bidder is only given for offers",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			var bidder sdk.AccAddress
+			if len(args) > 2 {
+				var err error
+				bidder, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgRetryFailedQueueItem(cliCtx.GetFromAddress(), args[0], args[1], bidder)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
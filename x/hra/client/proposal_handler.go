@@ -9,4 +9,9 @@ import (
 var (
 	RegisterBlockchainIdProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitRegisterBlockchainIdProposal)
 	RemoveBlockchainIdProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitRemoveBlockchainIdProposal)
+	SetPricingScheduleProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSetPricingScheduleProposal)
+	ReserveNamesProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitReserveNamesProposal)
+	ReleaseNamesProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitReleaseNamesProposal)
+	RevokeNameProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitRevokeNameProposal)
+	SetNameHistoryRetentionProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSetNameHistoryRetentionProposal)
 )
\ No newline at end of file
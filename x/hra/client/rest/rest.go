@@ -10,6 +10,9 @@ import (
 
 const (
 	restName = "name"
+	restAddress = "address"
+	restKey = "key"
+	restPrefix = "prefix"
 )
 
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
@@ -22,5 +25,45 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/transfer", storeName, restName), transferNameHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/addresses", storeName), registerAddressHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/addresses", storeName), removeAddressHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/addresses", storeName), setAddressesHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/auction", storeName, restName), openAuctionHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/auction", storeName, restName), queryAuctionHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/auction/bid", storeName, restName), placeBidHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/auction/commit", storeName, restName), commitBidHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/auction/reveal", storeName, restName), revealBidHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/auction/sealed-bids", storeName, restName), querySealedBidsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/auctions", storeName), queryAuctionsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/subnames", storeName), registerSubnameHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/subnames/addresses", storeName), registerSubnameAddressHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/subnames/addresses", storeName), removeSubnameAddressHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/subnames", storeName, restName), querySubnamesHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/primary", storeName, restName), setPrimaryNameHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/reverse/{%s}", storeName, restAddress), reverseResolveHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/text-records", storeName, restName), setTextRecordHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/text-records", storeName, restName), removeTextRecordHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/text-records", storeName, restName), queryTextRecordsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/text-records/{%s}", storeName, restName, restKey), queryTextRecordHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/reserved-names", storeName), queryReservedNamesHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/price-quote", storeName, restName), queryPriceQuoteHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/operators", storeName, restName), approveOperatorHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/operators", storeName, restName), revokeOperatorHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/operators", storeName, restName), queryOperatorsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/operators/addresses", storeName, restName), operatorRegisterAddressHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/operators/addresses", storeName, restName), operatorRemoveAddressHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/offers", storeName, restName), placeOfferHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/offers", storeName, restName), withdrawOfferHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/offers", storeName, restName), queryOffersHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/offers/accept", storeName, restName), acceptOfferHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/offers/{%s}", storeName, restAddress), queryBidderOffersHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names", storeName), queryAllNamesHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/search/{%s}", storeName, restPrefix), queryNamesByPrefixHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names-for-sale", storeName), queryNamesForSaleHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/expiring-names", storeName), queryExpiringNamesHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/history", storeName, restName), queryNameHistoryHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/pending-transfer", storeName, restName), startTransferHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/pending-transfer", storeName, restName), cancelTransferHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/pending-transfer", storeName, restName), queryPendingTransferHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/pending-transfers", storeName), queryPendingTransfersHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/pending-transfers/{%s}", storeName, restAddress), queryPendingTransfersHandler(cliCtx, storeName)).Methods("GET")
 }
 
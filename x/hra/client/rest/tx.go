@@ -1,8 +1,10 @@
 package rest
 
 import (
+	"encoding/hex"
 	denom "github.com/anathatech/project-anatha/utils"
 	"net/http"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/anathatech/project-anatha/x/hra/internal/types"
@@ -16,6 +18,7 @@ type registerNameReq struct {
 	BaseReq rest.BaseReq                    `json:"base_req" yaml:"base_req"`
 	Name    string                          `json:"name" yaml:"name"`
 	Owner   string                          `json:"owner" yaml:"owner"`
+	Periods uint64                          `json:"periods" yaml:"periods"`
 	Addresses []types.BlockchainAddressInfo `json:"addresses" yaml:"addresses"`
 }
 func registerNameHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -38,7 +41,7 @@ func registerNameHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// create the message
-		msg := types.NewMsgRegisterName(req.Name, addr)
+		msg := types.NewMsgRegisterName(req.Name, addr, req.Periods)
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -53,6 +56,7 @@ type renewNameReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 	Name    string       `json:"name" yaml:"name"`
 	Owner   string       `json:"owner" yaml:"owner"`
+	Periods uint64       `json:"periods" yaml:"periods"`
 }
 func renewNameHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -74,7 +78,7 @@ func renewNameHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// create the message
-		msg := types.NewMsgRenewName(req.Name, addr)
+		msg := types.NewMsgRenewName(req.Name, addr, req.Periods)
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -316,4 +320,830 @@ func removeAddressHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
-}
\ No newline at end of file
+}
+
+type openAuctionReq struct {
+	BaseReq 		rest.BaseReq 	`json:"base_req" yaml:"base_req"`
+	Name    		string       	`json:"name" yaml:"name"`
+	Owner   		string       	`json:"owner" yaml:"owner"`
+	AuctionType 	string 			`json:"auction_type" yaml:"auction_type"`
+	ReservePrice 	string 			`json:"reserve_price" yaml:"reserve_price"`
+	Duration 		string 			`json:"duration" yaml:"duration"`
+}
+func openAuctionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req openAuctionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		reservePrice, err := denom.ParseAndConvertCoins(req.ReservePrice)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		duration, err := time.ParseDuration(req.Duration)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgOpenAuction(req.Name, addr, req.AuctionType, reservePrice, duration)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type placeBidReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Bidder  string       `json:"bidder" yaml:"bidder"`
+	Amount 	string		 `json:"amount" yaml:"amount"`
+}
+func placeBidHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req placeBidReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Bidder)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		amount, err := denom.ParseAndConvertCoins(req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgPlaceBid(req.Name, addr, amount)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type commitBidReq struct {
+	BaseReq 	rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    	string       `json:"name" yaml:"name"`
+	Bidder  	string       `json:"bidder" yaml:"bidder"`
+	Commitment 	string 		 `json:"commitment" yaml:"commitment"`
+	Deposit 	string		 `json:"deposit" yaml:"deposit"`
+}
+func commitBidHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req commitBidReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Bidder)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		commitment, err := hex.DecodeString(req.Commitment)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		deposit, err := denom.ParseAndConvertCoins(req.Deposit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCommitBid(req.Name, addr, commitment, deposit)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type revealBidReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Bidder  string       `json:"bidder" yaml:"bidder"`
+	Amount 	string		 `json:"amount" yaml:"amount"`
+	Salt 	string 		 `json:"salt" yaml:"salt"`
+}
+func revealBidHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revealBidReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Bidder)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		amount, err := denom.ParseAndConvertCoins(req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRevealBid(req.Name, addr, amount, req.Salt)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+type registerSubnameReq struct {
+	BaseReq 		rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    		string       `json:"name" yaml:"name"`
+	Owner   		string       `json:"owner" yaml:"owner"`
+	SubnameOwner 	string       `json:"subname_owner" yaml:"subname_owner"`
+}
+func registerSubnameHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req registerSubnameReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		subnameOwner, err := sdk.AccAddressFromBech32(req.SubnameOwner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRegisterSubname(req.Name, owner, subnameOwner)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type registerSubnameAddressReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+	BlockchainId string		`json:"blockchain_id" yaml:"blockchain_id"`
+	Index string			`json:"index" yaml:"index"`
+	BlockchainAddress string`json:"blockchain_address" yaml:"blockchain_address"`
+}
+func registerSubnameAddressHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req registerSubnameAddressReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRegisterSubnameAddress(req.Name, owner, req.BlockchainId, req.Index, req.BlockchainAddress)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type removeSubnameAddressReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+	BlockchainId string		`json:"blockchain_id" yaml:"blockchain_id"`
+	Index string			`json:"index" yaml:"index"`
+}
+func removeSubnameAddressHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req removeSubnameAddressReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRemoveSubnameAddress(req.Name, owner, req.BlockchainId, req.Index)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setPrimaryNameReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+}
+func setPrimaryNameHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setPrimaryNameReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetPrimaryName(req.Name, owner)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTextRecordReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+	Key     string       `json:"key" yaml:"key"`
+	Value   string       `json:"value" yaml:"value"`
+}
+func setTextRecordHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setTextRecordReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetTextRecord(req.Name, owner, req.Key, req.Value)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type removeTextRecordReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+	Key     string       `json:"key" yaml:"key"`
+}
+func removeTextRecordHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req removeTextRecordReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRemoveTextRecord(req.Name, owner, req.Key)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type approveOperatorReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name     string       `json:"name" yaml:"name"`
+	Owner    string       `json:"owner" yaml:"owner"`
+	Operator string       `json:"operator" yaml:"operator"`
+}
+func approveOperatorHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req approveOperatorReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		operator, err := sdk.AccAddressFromBech32(req.Operator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgApproveOperator(req.Name, owner, operator)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type revokeOperatorReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name     string       `json:"name" yaml:"name"`
+	Owner    string       `json:"owner" yaml:"owner"`
+	Operator string       `json:"operator" yaml:"operator"`
+}
+func revokeOperatorHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revokeOperatorReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		operator, err := sdk.AccAddressFromBech32(req.Operator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRevokeOperator(req.Name, owner, operator)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type operatorRegisterAddressReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name              string       `json:"name" yaml:"name"`
+	Operator          string       `json:"operator" yaml:"operator"`
+	BlockchainId      string       `json:"blockchain_id" yaml:"blockchain_id"`
+	Index             string       `json:"index" yaml:"index"`
+	BlockchainAddress string       `json:"blockchain_address" yaml:"blockchain_address"`
+}
+func operatorRegisterAddressHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req operatorRegisterAddressReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		operator, err := sdk.AccAddressFromBech32(req.Operator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgOperatorRegisterAddress(req.Name, operator, req.BlockchainId, req.Index, req.BlockchainAddress)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type operatorRemoveAddressReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name         string       `json:"name" yaml:"name"`
+	Operator     string       `json:"operator" yaml:"operator"`
+	BlockchainId string       `json:"blockchain_id" yaml:"blockchain_id"`
+	Index        string       `json:"index" yaml:"index"`
+}
+func operatorRemoveAddressHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req operatorRemoveAddressReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		operator, err := sdk.AccAddressFromBech32(req.Operator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgOperatorRemoveAddress(req.Name, operator, req.BlockchainId, req.Index)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type placeOfferReq struct {
+	BaseReq 	rest.BaseReq 	`json:"base_req" yaml:"base_req"`
+	Name    	string       	`json:"name" yaml:"name"`
+	Bidder   	string       	`json:"bidder" yaml:"bidder"`
+	Amount 		string 			`json:"amount" yaml:"amount"`
+	Duration 	string 			`json:"duration" yaml:"duration"`
+}
+func placeOfferHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req placeOfferReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Bidder)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		amount, err := denom.ParseAndConvertCoins(req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		duration, err := time.ParseDuration(req.Duration)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgPlaceOffer(req.Name, addr, amount, duration)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type acceptOfferReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+	Bidder  string       `json:"bidder" yaml:"bidder"`
+}
+func acceptOfferHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req acceptOfferReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bidder, err := sdk.AccAddressFromBech32(req.Bidder)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgAcceptOffer(req.Name, owner, bidder)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type withdrawOfferReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Bidder  string       `json:"bidder" yaml:"bidder"`
+}
+func withdrawOfferHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req withdrawOfferReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		bidder, err := sdk.AccAddressFromBech32(req.Bidder)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgWithdrawOffer(req.Name, bidder)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setAddressesReq struct {
+	BaseReq 	rest.BaseReq 					`json:"base_req" yaml:"base_req"`
+	Owner   	string       					`json:"owner" yaml:"owner"`
+	Addresses 	[]types.BlockchainAddressInfo 	`json:"addresses" yaml:"addresses"`
+	Removals 	[]types.BlockchainAddressInfo 	`json:"removals" yaml:"removals"`
+}
+func setAddressesHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setAddressesReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetAddresses(addr, req.Addresses, req.Removals)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type startTransferReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+	NewOwner string		 `json:"new_owner" yaml:"new_owner"`
+}
+func startTransferHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req startTransferReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		newOwner, err := sdk.AccAddressFromBech32(req.NewOwner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgStartTransfer(req.Name, owner, newOwner)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type cancelTransferReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+}
+func cancelTransferHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req cancelTransferReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCancelTransfer(req.Name, owner)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+type NamesProposalJSON struct {
+	Title 			string 		`json:"title" yaml:"title"`
+	Description 	string 		`json:"description" yaml:"description"`
+	Names 			[]string 	`json:"names" yaml:"names"`
+}
+
+type RevokeNameProposalJSON struct {
+	Title 			string `json:"title" yaml:"title"`
+	Description 	string `json:"description" yaml:"description"`
+	Name 			string `json:"name" yaml:"name"`
+}
+
+type NameHistoryRetentionProposalJSON struct {
+	Title 			string `json:"title" yaml:"title"`
+	Description 	string `json:"description" yaml:"description"`
+	Retention 		uint64 `json:"retention" yaml:"retention"`
+}
+
+func ParseNamesProposalJSON(cdc *codec.Codec, proposalFile string) (NamesProposalJSON, error) {
+	proposal := NamesProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+func ParseRevokeNameProposalJSON(cdc *codec.Codec, proposalFile string) (RevokeNameProposalJSON, error) {
+	proposal := RevokeNameProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+func ParseNameHistoryRetentionProposalJSON(cdc *codec.Codec, proposalFile string) (NameHistoryRetentionProposalJSON, error) {
+	proposal := NameHistoryRetentionProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+type PricingScheduleProposalJSON struct {
+	Title 			string 					`json:"title" yaml:"title"`
+	Description 	string 					`json:"description" yaml:"description"`
+	PricingSchedule types.PricingSchedule 	`json:"pricing_schedule" yaml:"pricing_schedule"`
+}
+
+func ParsePricingScheduleProposalJSON(cdc *codec.Codec, proposalFile string) (PricingScheduleProposalJSON, error) {
+	proposal := PricingScheduleProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -13,10 +13,19 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.Valid
 		keeper.SetRegisteredBlockchainId(ctx, record)
 	}
 
+	for _, record := range data.AddressValidators {
+		keeper.SetAddressValidator(ctx, record.BlockchainId, record.AddressValidator)
+	}
+
 	for _, record := range data.NameRecords {
 		keeper.SetNameInfo(ctx, record.Name, record)
 
-		keeper.InsertExpiredNameInfoQueue(ctx, record.Name, record.ExpiryTime)
+		// subnames expire together with their parent
+		if record.IsSubname() {
+			keeper.SetSubname(ctx, record.Parent, record.Name)
+		} else {
+			keeper.InsertExpiredNameInfoQueue(ctx, record.Name, record.ExpiryTime)
+		}
 	}
 
 	for _, record := range data.AddressCredits {
@@ -27,6 +36,48 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.Valid
 		keeper.SetAddress(ctx, record.Address, record.BlockchainAddressInfo.BlockchainId, record.BlockchainAddressInfo.Index, record.BlockchainAddressInfo.BlockchainAddress)
 	}
 
+	for _, record := range data.NameAddressRecords {
+		keeper.SetNameAddress(ctx, record.Name, record.BlockchainAddressInfo.BlockchainId, record.BlockchainAddressInfo.Index, record.BlockchainAddressInfo.BlockchainAddress)
+	}
+
+	for _, record := range data.Auctions {
+		keeper.SetAuction(ctx, record)
+
+		keeper.InsertAuctionQueue(ctx, record.Name, record.SettlementTime())
+	}
+
+	for _, record := range data.SealedBids {
+		keeper.SetSealedBid(ctx, record)
+	}
+
+	for _, record := range data.PrimaryNames {
+		keeper.SetPrimaryName(ctx, record.Address, record.Name)
+	}
+
+	for _, record := range data.TextRecords {
+		keeper.SetTextRecord(ctx, record.Name, record.Key, record.Value)
+	}
+
+	for _, record := range data.Operators {
+		keeper.SetOperator(ctx, record.Name, record.Operator)
+	}
+
+	for _, record := range data.ReservedNames {
+		keeper.SetReservedName(ctx, record)
+	}
+
+	for _, record := range data.Offers {
+		keeper.SetOffer(ctx, record)
+	}
+
+	for _, record := range data.NameHistory {
+		keeper.SetNameHistoryEntry(ctx, record)
+	}
+
+	for _, record := range data.PendingTransfers {
+		keeper.SetPendingTransfer(ctx, record)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -58,11 +109,92 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		return false
 	})
 
+	var nameAddressRecords []types.NameAddressRecordInfo
+	k.IterateAllNameAddressInfos(ctx, func (nameAddressRecord types.NameAddressRecordInfo) (stop bool) {
+		nameAddressRecords = append(nameAddressRecords, nameAddressRecord)
+
+		return false
+	})
+
+	var auctions []types.Auction
+	k.IterateAuctions(ctx, func (auction types.Auction) (stop bool) {
+		auctions = append(auctions, auction)
+
+		return false
+	})
+
+	var sealedBids []types.SealedBid
+	k.IterateAllSealedBids(ctx, func (sealedBid types.SealedBid) (stop bool) {
+		sealedBids = append(sealedBids, sealedBid)
+
+		return false
+	})
+
+	var primaryNames []types.PrimaryNameInfo
+	k.IteratePrimaryNames(ctx, func (address sdk.AccAddress, name string) (stop bool) {
+		primaryNames = append(primaryNames, types.NewPrimaryNameInfo(address, name))
+
+		return false
+	})
+
+	var textRecords []types.TextRecord
+	k.IterateAllTextRecords(ctx, func (textRecord types.TextRecord) (stop bool) {
+		textRecords = append(textRecords, textRecord)
+
+		return false
+	})
+
+	var addressValidators []types.BlockchainIdAddressValidatorInfo
+	k.IterateAddressValidators(ctx, func (blockchainId string, addressValidator string) (stop bool) {
+		addressValidators = append(addressValidators, types.NewBlockchainIdAddressValidatorInfo(blockchainId, addressValidator))
+
+		return false
+	})
+
+	var operators []types.OperatorInfo
+	k.IterateAllOperators(ctx, func (operatorInfo types.OperatorInfo) (stop bool) {
+		operators = append(operators, operatorInfo)
+
+		return false
+	})
+
+	var offers []types.Offer
+	k.IterateAllOffers(ctx, func (offer types.Offer) (stop bool) {
+		offers = append(offers, offer)
+
+		return false
+	})
+
+	var nameHistory []types.NameHistoryEntry
+	k.IterateAllNameHistory(ctx, func (entry types.NameHistoryEntry) (stop bool) {
+		nameHistory = append(nameHistory, entry)
+
+		return false
+	})
+
+	var pendingTransfers []types.PendingTransfer
+	k.IteratePendingTransfers(ctx, func (transfer types.PendingTransfer) (stop bool) {
+		pendingTransfers = append(pendingTransfers, transfer)
+
+		return false
+	})
+
 	return GenesisState{
 		Params:      params,
 		NameRecords: nameInfos,
 		AddressCredits: addressCredits,
 		AddressRecords: addressRecords,
+		NameAddressRecords: nameAddressRecords,
 		RegisteredBlockchainIds: k.GetRegisteredBlockchainIds(ctx),
+		Auctions: auctions,
+		SealedBids: sealedBids,
+		PrimaryNames: primaryNames,
+		TextRecords: textRecords,
+		AddressValidators: addressValidators,
+		Operators: operators,
+		ReservedNames: k.GetReservedNames(ctx),
+		Offers: offers,
+		NameHistory: nameHistory,
+		PendingTransfers: pendingTransfers,
 	}
 }
\ No newline at end of file
@@ -5,6 +5,8 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/anathatech/project-anatha/x/hra/internal/types"
+	"strconv"
+	"strings"
 )
 
 func NewHandler(k Keeper) sdk.Handler {
@@ -29,6 +31,48 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgRemoveAddress(ctx, msg, k)
 		case MsgRemoveAllAddresses:
 			return handleMsgRemoveAllAddresses(ctx, msg, k)
+		case MsgOpenAuction:
+			return handleMsgOpenAuction(ctx, msg, k)
+		case MsgPlaceBid:
+			return handleMsgPlaceBid(ctx, msg, k)
+		case MsgCommitBid:
+			return handleMsgCommitBid(ctx, msg, k)
+		case MsgRevealBid:
+			return handleMsgRevealBid(ctx, msg, k)
+		case MsgRegisterSubname:
+			return handleMsgRegisterSubname(ctx, msg, k)
+		case MsgRegisterSubnameAddress:
+			return handleMsgRegisterSubnameAddress(ctx, msg, k)
+		case MsgRemoveSubnameAddress:
+			return handleMsgRemoveSubnameAddress(ctx, msg, k)
+		case MsgSetPrimaryName:
+			return handleMsgSetPrimaryName(ctx, msg, k)
+		case MsgSetTextRecord:
+			return handleMsgSetTextRecord(ctx, msg, k)
+		case MsgRemoveTextRecord:
+			return handleMsgRemoveTextRecord(ctx, msg, k)
+		case MsgApproveOperator:
+			return handleMsgApproveOperator(ctx, msg, k)
+		case MsgRevokeOperator:
+			return handleMsgRevokeOperator(ctx, msg, k)
+		case MsgOperatorRegisterAddress:
+			return handleMsgOperatorRegisterAddress(ctx, msg, k)
+		case MsgOperatorRemoveAddress:
+			return handleMsgOperatorRemoveAddress(ctx, msg, k)
+		case MsgPlaceOffer:
+			return handleMsgPlaceOffer(ctx, msg, k)
+		case MsgAcceptOffer:
+			return handleMsgAcceptOffer(ctx, msg, k)
+		case MsgWithdrawOffer:
+			return handleMsgWithdrawOffer(ctx, msg, k)
+		case MsgSetAddresses:
+			return handleMsgSetAddresses(ctx, msg, k)
+		case MsgStartTransfer:
+			return handleMsgStartTransfer(ctx, msg, k)
+		case MsgCancelTransfer:
+			return handleMsgCancelTransfer(ctx, msg, k)
+		case MsgRetryFailedQueueItem:
+			return handleMsgRetryFailedQueueItem(ctx, msg, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -44,6 +88,20 @@ func NewGovernanceProposalHandler(k Keeper) govtypes.Handler {
 			case types.RemoveBlockchainIdProposal:
 				return handleProposalRemoveBlockchainId(ctx, k, c)
 
+			case types.SetPricingScheduleProposal:
+				return handleProposalSetPricingSchedule(ctx, k, c)
+
+			case types.ReserveNamesProposal:
+				return handleProposalReserveNames(ctx, k, c)
+
+			case types.ReleaseNamesProposal:
+				return handleProposalReleaseNames(ctx, k, c)
+
+			case types.RevokeNameProposal:
+				return handleProposalRevokeName(ctx, k, c)
+			case types.SetNameHistoryRetentionProposal:
+				return handleProposalSetNameHistoryRetention(ctx, k, c)
+
 			default:
 					return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized hra proposal content type: %T", c)
 		}
@@ -53,12 +111,110 @@ func NewGovernanceProposalHandler(k Keeper) govtypes.Handler {
 func handleProposalRegisterBlockchainId(ctx sdk.Context, k Keeper, proposal types.RegisterBlockchainIdProposal) error {
 	k.SetRegisteredBlockchainId(ctx, proposal.BlockchainId)
 
+	if proposal.AddressValidator != "" {
+		k.SetAddressValidator(ctx, proposal.BlockchainId, proposal.AddressValidator)
+	} else {
+		k.RemoveAddressValidator(ctx, proposal.BlockchainId)
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeRegisterBlockchainId,
 			sdk.NewAttribute(types.AttributeKeyTitle, proposal.Title),
 			sdk.NewAttribute(types.AttributeKeyDescription, proposal.Description),
 			sdk.NewAttribute(types.AttributeKeyBlockchainId, proposal.BlockchainId),
+			sdk.NewAttribute(types.AttributeKeyAddressValidator, k.GetAddressValidator(ctx, proposal.BlockchainId)),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	return nil
+}
+
+func handleProposalSetPricingSchedule(ctx sdk.Context, k Keeper, proposal types.SetPricingScheduleProposal) error {
+	k.SetNameInfoPricingSchedule(ctx, proposal.PricingSchedule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetPricingSchedule,
+			sdk.NewAttribute(types.AttributeKeyTitle, proposal.Title),
+			sdk.NewAttribute(types.AttributeKeyDescription, proposal.Description),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	return nil
+}
+
+func handleProposalSetNameHistoryRetention(ctx sdk.Context, k Keeper, proposal types.SetNameHistoryRetentionProposal) error {
+	k.SetNameHistoryRetention(ctx, proposal.Retention)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetNameHistoryRetention,
+			sdk.NewAttribute(types.AttributeKeyTitle, proposal.Title),
+			sdk.NewAttribute(types.AttributeKeyDescription, proposal.Description),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	return nil
+}
+
+func handleProposalReserveNames(ctx sdk.Context, k Keeper, proposal types.ReserveNamesProposal) error {
+	for _, name := range proposal.Names {
+		k.SetReservedName(ctx, name)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReserveNames,
+			sdk.NewAttribute(types.AttributeKeyTitle, proposal.Title),
+			sdk.NewAttribute(types.AttributeKeyDescription, proposal.Description),
+			sdk.NewAttribute(types.AttributeKeyNames, strings.Join(proposal.Names, ",")),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	return nil
+}
+
+func handleProposalReleaseNames(ctx sdk.Context, k Keeper, proposal types.ReleaseNamesProposal) error {
+	for _, name := range proposal.Names {
+		k.RemoveReservedName(ctx, name)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseNames,
+			sdk.NewAttribute(types.AttributeKeyTitle, proposal.Title),
+			sdk.NewAttribute(types.AttributeKeyDescription, proposal.Description),
+			sdk.NewAttribute(types.AttributeKeyNames, strings.Join(proposal.Names, ",")),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	return nil
+}
+
+func handleProposalRevokeName(ctx sdk.Context, k Keeper, proposal types.RevokeNameProposal) error {
+	nameInfo, found := k.GetNameInfo(ctx, proposal.Name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	err := k.RevokeName(ctx, proposal.Name)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeName,
+			sdk.NewAttribute(types.AttributeKeyTitle, proposal.Title),
+			sdk.NewAttribute(types.AttributeKeyDescription, proposal.Description),
+			sdk.NewAttribute(types.AttributeKeyName, proposal.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, nameInfo.Owner.String()),
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
 		),
 	)
@@ -68,6 +224,7 @@ func handleProposalRegisterBlockchainId(ctx sdk.Context, k Keeper, proposal type
 
 func handleProposalRemoveBlockchainId(ctx sdk.Context, k Keeper, proposal types.RemoveBlockchainIdProposal) error {
 	k.RemoveRegisteredBlockchainId(ctx, proposal.BlockchainId)
+	k.RemoveAddressValidator(ctx, proposal.BlockchainId)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -83,7 +240,7 @@ func handleProposalRemoveBlockchainId(ctx sdk.Context, k Keeper, proposal types.
 }
 
 func handleMsgRegisterName(ctx sdk.Context, msg MsgRegisterName, k Keeper) (*sdk.Result, error) {
-	err := k.HandleRegisterName(ctx, msg.Name, msg.Owner)
+	err := k.HandleRegisterName(ctx, msg.Name, msg.Owner, msg.GetPeriods())
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +249,7 @@ func handleMsgRegisterName(ctx sdk.Context, msg MsgRegisterName, k Keeper) (*sdk
 }
 
 func handleMsgRenewName(ctx sdk.Context, msg MsgRenewName, k Keeper) (*sdk.Result, error) {
-	err := k.HandleRenewName(ctx, msg.Name, msg.Owner)
+	err := k.HandleRenewName(ctx, msg.Name, msg.Owner, msg.GetPeriods())
 
 	if err != nil {
 		return nil, err
@@ -251,4 +408,480 @@ func handleMsgRemoveAllAddresses(ctx sdk.Context, msg MsgRemoveAllAddresses, k K
 	))
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
-}
\ No newline at end of file
+}
+
+func handleMsgOpenAuction(ctx sdk.Context, msg MsgOpenAuction, k Keeper) (*sdk.Result, error) {
+	err := k.HandleOpenAuction(ctx, msg.Name, msg.Owner, msg.AuctionType, msg.ReservePrice, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeOpenAuction,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, msg.AuctionType),
+			sdk.NewAttribute(types.AttributeKeyReservePrice, msg.ReservePrice.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, ctx.BlockTime().Add(msg.Duration).String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgPlaceBid(ctx sdk.Context, msg MsgPlaceBid, k Keeper) (*sdk.Result, error) {
+	err := k.HandlePlaceBid(ctx, msg.Name, msg.Bidder, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePlaceBid,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyBidder, msg.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Bidder.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCommitBid(ctx sdk.Context, msg MsgCommitBid, k Keeper) (*sdk.Result, error) {
+	err := k.HandleCommitBid(ctx, msg.Name, msg.Bidder, msg.Commitment, msg.Deposit)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCommitBid,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyBidder, msg.Bidder.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Bidder.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevealBid(ctx sdk.Context, msg MsgRevealBid, k Keeper) (*sdk.Result, error) {
+	err := k.HandleRevealBid(ctx, msg.Name, msg.Bidder, msg.Amount, msg.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevealBid,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyBidder, msg.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Bidder.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+func handleMsgRegisterSubname(ctx sdk.Context, msg MsgRegisterSubname, k Keeper) (*sdk.Result, error) {
+	err := k.HandleRegisterSubname(ctx, msg.Name, msg.Owner, msg.SubnameOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRegisterSubnameAddress(ctx sdk.Context, msg MsgRegisterSubnameAddress, k Keeper) (*sdk.Result, error) {
+	err := k.HandleRegisterSubnameAddress(ctx, msg.Name, msg.Owner, msg.BlockchainId, msg.Index, msg.BlockchainAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterAddress,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyBlockchainId, msg.BlockchainId),
+			sdk.NewAttribute(types.AttributeKeyIndex, msg.Index),
+			sdk.NewAttribute(types.AttributeKeyBlockchainAddress, msg.BlockchainAddress),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRemoveSubnameAddress(ctx sdk.Context, msg MsgRemoveSubnameAddress, k Keeper) (*sdk.Result, error) {
+	err := k.HandleRemoveSubnameAddress(ctx, msg.Name, msg.Owner, msg.BlockchainId, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveAddress,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyBlockchainId, msg.BlockchainId),
+			sdk.NewAttribute(types.AttributeKeyIndex, msg.Index),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetPrimaryName(ctx sdk.Context, msg MsgSetPrimaryName, k Keeper) (*sdk.Result, error) {
+	err := k.HandleSetPrimaryName(ctx, msg.Name, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetPrimaryName,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetTextRecord(ctx sdk.Context, msg MsgSetTextRecord, k Keeper) (*sdk.Result, error) {
+	err := k.HandleSetTextRecord(ctx, msg.Name, msg.Owner, msg.Key, msg.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetTextRecord,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyKey, msg.Key),
+			sdk.NewAttribute(types.AttributeKeyValue, msg.Value),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRemoveTextRecord(ctx sdk.Context, msg MsgRemoveTextRecord, k Keeper) (*sdk.Result, error) {
+	err := k.HandleRemoveTextRecord(ctx, msg.Name, msg.Owner, msg.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveTextRecord,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyKey, msg.Key),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgApproveOperator(ctx sdk.Context, msg MsgApproveOperator, k Keeper) (*sdk.Result, error) {
+	err := k.HandleApproveOperator(ctx, msg.Name, msg.Owner, msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApproveOperator,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevokeOperator(ctx sdk.Context, msg MsgRevokeOperator, k Keeper) (*sdk.Result, error) {
+	err := k.HandleRevokeOperator(ctx, msg.Name, msg.Owner, msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeOperator,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgOperatorRegisterAddress(ctx sdk.Context, msg MsgOperatorRegisterAddress, k Keeper) (*sdk.Result, error) {
+	err := k.HandleOperatorRegisterAddress(ctx, msg.Name, msg.Operator, msg.BlockchainId, msg.Index, msg.BlockchainAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterAddress,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockchainId, msg.BlockchainId),
+			sdk.NewAttribute(types.AttributeKeyIndex, msg.Index),
+			sdk.NewAttribute(types.AttributeKeyBlockchainAddress, msg.BlockchainAddress),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Operator.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgOperatorRemoveAddress(ctx sdk.Context, msg MsgOperatorRemoveAddress, k Keeper) (*sdk.Result, error) {
+	err := k.HandleOperatorRemoveAddress(ctx, msg.Name, msg.Operator, msg.BlockchainId, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveAddress,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockchainId, msg.BlockchainId),
+			sdk.NewAttribute(types.AttributeKeyIndex, msg.Index),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Operator.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgPlaceOffer(ctx sdk.Context, msg MsgPlaceOffer, k Keeper) (*sdk.Result, error) {
+	err := k.HandlePlaceOffer(ctx, msg.Name, msg.Bidder, msg.Amount, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePlaceOffer,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyBidder, msg.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyExpires, ctx.BlockTime().Add(msg.Duration).String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Bidder.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAcceptOffer(ctx sdk.Context, msg MsgAcceptOffer, k Keeper) (*sdk.Result, error) {
+	offer, found := k.GetOffer(ctx, msg.Name, msg.Bidder)
+	if ! found {
+		return nil, types.ErrOfferNotFound
+	}
+
+	err := k.HandleAcceptOffer(ctx, msg.Name, msg.Owner, msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAcceptOffer,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, msg.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, offer.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgWithdrawOffer(ctx sdk.Context, msg MsgWithdrawOffer, k Keeper) (*sdk.Result, error) {
+	offer, found := k.GetOffer(ctx, msg.Name, msg.Bidder)
+	if ! found {
+		return nil, types.ErrOfferNotFound
+	}
+
+	err := k.HandleWithdrawOffer(ctx, msg.Name, msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawOffer,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyBidder, msg.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, offer.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Bidder.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetAddresses(ctx sdk.Context, msg MsgSetAddresses, k Keeper) (*sdk.Result, error) {
+	err := k.HandleSetAddresses(ctx, msg.Owner, msg.Addresses, msg.Removals)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAddresses,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyUpserted, strconv.Itoa(len(msg.Addresses))),
+			sdk.NewAttribute(types.AttributeKeyRemoved, strconv.Itoa(len(msg.Removals))),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgStartTransfer(ctx sdk.Context, msg MsgStartTransfer, k Keeper) (*sdk.Result, error) {
+	transfer, err := k.HandleStartTransfer(ctx, msg.Name, msg.Owner, msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStartTransfer,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, msg.NewOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, transfer.CompletionTime.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelTransfer(ctx sdk.Context, msg MsgCancelTransfer, k Keeper) (*sdk.Result, error) {
+	err := k.HandleCancelTransfer(ctx, msg.Name, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelTransfer,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRetryFailedQueueItem(ctx sdk.Context, msg MsgRetryFailedQueueItem, k Keeper) (*sdk.Result, error) {
+	err := k.HandleRetryFailedQueueItem(ctx, msg.Action, msg.Name, msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRetryFailedQueueItem,
+			sdk.NewAttribute(types.AttributeKeyAction, msg.Action),
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
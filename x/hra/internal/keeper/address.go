@@ -2,28 +2,44 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/anathatech/project-anatha/x/hra/internal/types"
 	"strings"
 )
 
 func (k Keeper) HandleRegisterAddress(ctx sdk.Context, address sdk.AccAddress, blockchainId string, index string, blockchainAddress string) error {
-	blockchainAddress = strings.TrimSpace(blockchainAddress)
-
 	if ! k.OwnsAnyName(ctx, address) {
 		return types.ErrNoNamesRegistered
 	}
 
+	return k.registerAddress(ctx, address, address, blockchainId, index, blockchainAddress)
+}
+
+func (k Keeper) registerAddress(ctx sdk.Context, address sdk.AccAddress, payer sdk.AccAddress, blockchainId string, index string, blockchainAddress string) error {
+	blockchainAddress = strings.TrimSpace(blockchainAddress)
+
 	if ! k.IsBlockchainIdRegistered(ctx, blockchainId) {
 		return types.ErrBlockchainIdNotValid
 	}
 
+	if err := k.ValidateBlockchainAddress(ctx, blockchainId, blockchainAddress); err != nil {
+		return err
+	}
+
+	// only new records use up a credit or are charged, updating a record is free
+	if k.HasAddress(ctx, address, blockchainId, index) {
+		k.SetAddress(ctx, address, blockchainId, index, blockchainAddress)
+
+		return nil
+	}
+
 	credits := k.GetCredits(ctx, address)
 
 	if credits.LTE(sdk.ZeroInt()) {
 		fee := k.AddressRegistrationFee(ctx)
 		err := k.SupplyKeeper.SendCoinsFromAccountToModule(
 			ctx,
-			address,
+			payer,
 			k.feeCollectorName,
 			fee,
 		)
@@ -39,6 +55,67 @@ func (k Keeper) HandleRegisterAddress(ctx sdk.Context, address sdk.AccAddress, b
 	return nil
 }
 
+// HandleSetAddresses validates the whole batch against the registered blockchain ids before applying any change.
+// Every new record uses up a credit, the fee for the records exceeding the credits is charged at once. Updates of
+// existing records are free.
+func (k Keeper) HandleSetAddresses(ctx sdk.Context, address sdk.AccAddress, addresses []types.BlockchainAddressInfo, removals []types.BlockchainAddressInfo) error {
+	if len(addresses) > 0 && ! k.OwnsAnyName(ctx, address) {
+		return types.ErrNoNamesRegistered
+	}
+
+	for i := range addresses {
+		addresses[i].BlockchainAddress = strings.TrimSpace(addresses[i].BlockchainAddress)
+
+		if ! k.IsBlockchainIdRegistered(ctx, addresses[i].BlockchainId) {
+			return sdkerrors.Wrap(types.ErrBlockchainIdNotValid, addresses[i].BlockchainId)
+		}
+
+		if err := k.ValidateBlockchainAddress(ctx, addresses[i].BlockchainId, addresses[i].BlockchainAddress); err != nil {
+			return err
+		}
+	}
+
+	for _, removal := range removals {
+		if ! k.IsBlockchainIdRegistered(ctx, removal.BlockchainId) {
+			return sdkerrors.Wrap(types.ErrBlockchainIdNotValid, removal.BlockchainId)
+		}
+	}
+
+	credits := k.GetCredits(ctx, address)
+	fee := sdk.NewCoins()
+
+	for _, blockchainAddress := range addresses {
+		if k.HasAddress(ctx, address, blockchainAddress.BlockchainId, blockchainAddress.Index) {
+			continue
+		}
+
+		if credits.LTE(sdk.ZeroInt()) {
+			fee = fee.Add(k.AddressRegistrationFee(ctx)...)
+		} else {
+			credits = credits.Sub(sdk.OneInt())
+		}
+	}
+
+	if ! fee.IsZero() {
+		err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, address, k.feeCollectorName, fee)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.SetCredits(ctx, address, credits)
+
+	for _, removal := range removals {
+		k.RemoveAddress(ctx, address, removal.BlockchainId, removal.Index)
+	}
+
+	for _, blockchainAddress := range addresses {
+		k.SetAddress(ctx, address, blockchainAddress.BlockchainId, blockchainAddress.Index, blockchainAddress.BlockchainAddress)
+	}
+
+	return nil
+}
+
 func (k Keeper) HandleRemoveAddress(ctx sdk.Context, address sdk.AccAddress, blockchainId string, index string) error {
 	k.RemoveAddress(ctx, address, blockchainId, index)
 
@@ -57,6 +134,12 @@ func (k Keeper) SetAddress(ctx sdk.Context, address sdk.AccAddress, blockchainId
 	store.Set(types.GetAddressKey(address, blockchainId, index), []byte(blockchainAddress))
 }
 
+func (k Keeper) HasAddress(ctx sdk.Context, address sdk.AccAddress, blockchainId string, index string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetAddressKey(address, blockchainId, index))
+}
+
 func (k Keeper) RemoveAddress(ctx sdk.Context, address sdk.AccAddress, blockchainId string, index string) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -240,4 +323,57 @@ func (k Keeper) GetRegisteredBlockchainIds(ctx sdk.Context) []string {
 	})
 
 	return registeredBlockchainIds
-}
\ No newline at end of file
+}
+// GetAddressValidator returns the validator kind of a blockchain id. Validators set through governance take precedence
+// over the built-in defaults, and blockchain ids without either only get the length check. Blockchain ids are matched
+// case-insensitively.
+func (k Keeper) GetAddressValidator(ctx sdk.Context, blockchainId string) string {
+	store := ctx.KVStore(k.storeKey)
+
+	blockchainId = strings.ToLower(blockchainId)
+
+	addressValidator := store.Get(types.GetAddressValidatorKey(blockchainId))
+	if addressValidator != nil {
+		return string(addressValidator)
+	}
+
+	if kind, found := types.DefaultBlockchainIdAddressValidators[blockchainId]; found {
+		return kind
+	}
+
+	return types.AddressValidatorLength
+}
+
+func (k Keeper) SetAddressValidator(ctx sdk.Context, blockchainId string, addressValidator string) {
+	store := ctx.KVStore(k.storeKey)
+
+	blockchainId = strings.ToLower(blockchainId)
+
+	store.Set(types.GetAddressValidatorKey(blockchainId), []byte(addressValidator))
+}
+
+func (k Keeper) RemoveAddressValidator(ctx sdk.Context, blockchainId string) {
+	store := ctx.KVStore(k.storeKey)
+
+	blockchainId = strings.ToLower(blockchainId)
+
+	store.Delete(types.GetAddressValidatorKey(blockchainId))
+}
+
+func (k Keeper) IterateAddressValidators(ctx sdk.Context, cb func(blockchainId string, addressValidator string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.AddressValidatorKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()[1:]), string(iterator.Value())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) ValidateBlockchainAddress(ctx sdk.Context, blockchainId string, blockchainAddress string) error {
+	return types.ValidateBlockchainAddressWith(k.GetAddressValidator(ctx, blockchainId), blockchainAddress)
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func TestValidateBlockchainAddress(t *testing.T) {
+	input := createTestInput(t)
+
+	for _, tc := range []struct {
+		blockchainId string
+		address      string
+		valid        bool
+	}{
+		{"btc", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", true},
+		{"btc", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", true},
+		{"btc", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb", false},
+		{"BTC", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", false},
+		{"eth", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true},
+		{"eth", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", true},
+		{"eth", "0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed", false},
+		{"eth", "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", false},
+		{"xrp", "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", true},
+		{"xrp", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", false},
+		// blockchain ids without a validator only get the length check
+		{"bnb", "anything", true},
+	} {
+		err := input.keeper.ValidateBlockchainAddress(input.ctx, tc.blockchainId, tc.address)
+		if tc.valid {
+			require.NoError(t, err, "%s %s", tc.blockchainId, tc.address)
+		} else {
+			require.True(t, types.ErrBlockchainAddressNotValid.Is(err), "%s %s: %v", tc.blockchainId, tc.address, err)
+		}
+	}
+}
+
+func TestGovernanceAddressValidatorOverridesDefault(t *testing.T) {
+	input := createTestInput(t)
+
+	input.keeper.SetAddressValidator(input.ctx, "BNB", types.AddressValidatorEthereum)
+	require.Equal(t, types.AddressValidatorEthereum, input.keeper.GetAddressValidator(input.ctx, "bnb"))
+	require.Error(t, input.keeper.ValidateBlockchainAddress(input.ctx, "bnb", "anything"))
+
+	input.keeper.SetAddressValidator(input.ctx, "eth", types.AddressValidatorLength)
+	require.NoError(t, input.keeper.ValidateBlockchainAddress(input.ctx, "eth", "anything"))
+}
+
+func TestSetAddressesIsAllOrNothing(t *testing.T) {
+	input := createTestInput(t)
+	owner := testAddrs[0]
+
+	input.keeper.SetRegisteredBlockchainId(input.ctx, "btc")
+	input.keeper.SetRegisteredBlockchainId(input.ctx, "eth")
+
+	btc := types.NewBlockchainAddressInfo("btc", "0", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa")
+	eth := types.NewBlockchainAddressInfo("eth", "0", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	require.Equal(t, types.ErrNoNamesRegistered, input.keeper.HandleSetAddresses(input.ctx, owner, []types.BlockchainAddressInfo{btc}, nil))
+
+	registerName(t, input, "bulk", owner)
+	input.keeper.SetCredits(input.ctx, owner, sdk.OneInt())
+
+	// a single invalid entry rejects the whole batch
+	invalid := types.NewBlockchainAddressInfo("eth", "0", "not-an-address")
+	require.True(t, types.ErrBlockchainAddressNotValid.Is(input.keeper.HandleSetAddresses(input.ctx, owner, []types.BlockchainAddressInfo{btc, invalid}, nil)))
+
+	unknown := types.NewBlockchainAddressInfo("xyz", "0", "address")
+	require.True(t, types.ErrBlockchainIdNotValid.Is(input.keeper.HandleSetAddresses(input.ctx, owner, []types.BlockchainAddressInfo{btc, unknown}, nil)))
+
+	require.False(t, input.keeper.HasAddress(input.ctx, owner, "btc", "0"))
+	require.Equal(t, sdk.OneInt(), input.keeper.GetCredits(input.ctx, owner))
+
+	// the credit covers the first address, the second one is charged the fee
+	fund(t, input, owner, input.keeper.AddressRegistrationFee(input.ctx))
+	require.NoError(t, input.keeper.HandleSetAddresses(input.ctx, owner, []types.BlockchainAddressInfo{btc, eth}, nil))
+
+	require.True(t, input.keeper.HasAddress(input.ctx, owner, "btc", "0"))
+	require.True(t, input.keeper.HasAddress(input.ctx, owner, "eth", "0"))
+	require.True(t, input.keeper.GetCredits(input.ctx, owner).IsZero())
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, owner).IsZero())
+
+	// updating registered addresses and removing others is free
+	updated := types.NewBlockchainAddressInfo("eth", "0", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	require.NoError(t, input.keeper.HandleSetAddresses(input.ctx, owner, []types.BlockchainAddressInfo{updated}, []types.BlockchainAddressInfo{btc}))
+
+	require.False(t, input.keeper.HasAddress(input.ctx, owner, "btc", "0"))
+
+	address, err := input.keeper.GetAddress(input.ctx, owner, "eth", "0")
+	require.NoError(t, err)
+	require.Equal(t, updated.BlockchainAddress, address)
+}
@@ -1,10 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/anathatech/project-anatha/config"
 	"github.com/anathatech/project-anatha/x/hra/internal/types"
+	"time"
 )
 
 func (k Keeper) HandleSetPrice(ctx sdk.Context, name string, owner sdk.AccAddress, price sdk.Coins) error {
@@ -13,13 +14,30 @@ func (k Keeper) HandleSetPrice(ctx sdk.Context, name string, owner sdk.AccAddres
 		return types.ErrNameNotRegistered
 	}
 
-	if ! owner.Equals(nameInfo.Owner) {
+	if nameInfo.IsSubname() {
+		return types.ErrSubnameNotAllowed
+	}
+
+	if ! k.IsOwnerOrOperator(ctx, nameInfo, owner) {
 		return types.ErrNotOwner
 	}
 
+	if k.HasAuction(ctx, name) {
+		return types.ErrNameInAuction
+	}
+
+	if k.HasPendingTransfer(ctx, name) {
+		return types.ErrTransferPending
+	}
+
+	if k.IsNameExpired(ctx, nameInfo) {
+		return types.ErrNameExpired
+	}
+
 	nameInfo.Price = price
 
 	k.SetNameInfo(ctx, name, nameInfo)
+	k.AppendNameHistory(ctx, nameInfo, types.HistoryEventSetPrice, nil, price)
 
 	return nil
 }
@@ -39,6 +57,10 @@ func (k Keeper) HandleBuyName(ctx sdk.Context, name string, buyer sdk.AccAddress
 		return types.ErrNameNotRegistered
 	}
 
+	if nameInfo.IsSubname() {
+		return types.ErrSubnameNotAllowed
+	}
+
 	if nameInfo.Owner.Equals(buyer) {
 		return types.ErrAlreadyOwned
 	}
@@ -47,6 +69,18 @@ func (k Keeper) HandleBuyName(ctx sdk.Context, name string, buyer sdk.AccAddress
 		return types.ErrNotForSale
 	}
 
+	if k.IsNameExpired(ctx, nameInfo) {
+		return types.ErrNameExpired
+	}
+
+	if k.HasAuction(ctx, name) {
+		return types.ErrNameInAuction
+	}
+
+	if k.HasPendingTransfer(ctx, name) {
+		return types.ErrTransferPending
+	}
+
 	coins := nameInfo.Price
 
 	if ! k.CoinKeeper.HasCoins(ctx, buyer, coins) {
@@ -58,32 +92,405 @@ func (k Keeper) HandleBuyName(ctx sdk.Context, name string, buyer sdk.AccAddress
 		return err
 	}
 
-	if ! k.OwnsAnyName(ctx, buyer) {
-		k.SetCredits(ctx, buyer, k.AddressCredits(ctx))
-		k.AfterFirstNameCreated(ctx, buyer)
+	return k.ChangeNameOwner(ctx, nameInfo, buyer, coins)
+}
+func (k Keeper) HandleOpenAuction(ctx sdk.Context, name string, owner sdk.AccAddress, auctionType string, reservePrice sdk.Coins, duration time.Duration) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
 	}
 
-	// update the status mapping
-	k.DeleteNameInfoStatusMap(ctx, nameInfo.Owner, name)
-	k.SetNameInfoStatusMap(ctx,buyer, name)
+	if nameInfo.IsSubname() {
+		return types.ErrSubnameNotAllowed
+	}
 
-	oldOwner := nameInfo.Owner
+	if ! owner.Equals(nameInfo.Owner) {
+		return types.ErrNotOwner
+	}
 
-	// update the owner and reset the price
-	nameInfo.Owner = buyer
-	nameInfo.Price = sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, 0))
+	if k.HasAuction(ctx, name) {
+		return types.ErrNameInAuction
+	}
 
-	k.SetNameInfo(ctx, name, nameInfo)
+	if k.HasPendingTransfer(ctx, name) {
+		return types.ErrTransferPending
+	}
+
+	if k.IsNameExpired(ctx, nameInfo) {
+		return types.ErrNameExpired
+	}
+
+	startTime := ctx.BlockTime()
+	endTime := startTime.Add(duration)
+
+	revealEndTime := endTime
+	if auctionType == types.AuctionTypeSealed {
+		revealEndTime = endTime.Add(k.AuctionRevealDuration(ctx))
+	}
 
-	if ! k.OwnsAnyName(ctx, oldOwner) {
-		k.RemoveAllAddresses(ctx, oldOwner)
+	// the auction has to be settled while the name is still registered
+	if ! revealEndTime.Before(nameInfo.ExpiryTime) {
+		return sdkerrors.Wrap(types.ErrInvalidAuctionDuration, "Auction has to end before the name expires.")
+	}
+
+	auction := types.NewAuction(name, owner, auctionType, reservePrice, startTime, endTime, revealEndTime)
+
+	k.SetAuction(ctx, auction)
+	k.InsertAuctionQueue(ctx, name, auction.SettlementTime())
+
+	return nil
+}
+
+func (k Keeper) HandlePlaceBid(ctx sdk.Context, name string, bidder sdk.AccAddress, amount sdk.Coins) error {
+	auction, found := k.GetAuction(ctx, name)
+	if ! found {
+		return types.ErrAuctionNotFound
+	}
+
+	if auction.Type != types.AuctionTypeEnglish {
+		return sdkerrors.Wrap(types.ErrInvalidAuctionType, "Sealed-bid auctions require a committed bid.")
+	}
+
+	if ! ctx.BlockTime().Before(auction.EndTime) {
+		return types.ErrAuctionBiddingClosed
+	}
+
+	if auction.Owner.Equals(bidder) {
+		return types.ErrAlreadyOwned
+	}
+
+	if ! amount.IsAllGTE(auction.ReservePrice) || (auction.HasBids() && ! amount.IsAllGT(auction.HighestBid)) {
+		return types.ErrBidTooLow
+	}
 
-		k.SetCredits(ctx, oldOwner, sdk.ZeroInt())
-		err = k.AfterLastNameRemoved(ctx, oldOwner)
+	err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.AuctionEscrowModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	// the outbid funds are released right away
+	if auction.HasBids() {
+		err = k.RefundBid(ctx, name, auction.HighestBidder, auction.HighestBid)
 		if err != nil {
 			return err
 		}
 	}
 
+	auction.HighestBidder = bidder
+	auction.HighestBid = amount
+
+	k.SetAuction(ctx, auction)
+
+	return nil
+}
+
+func (k Keeper) HandleCommitBid(ctx sdk.Context, name string, bidder sdk.AccAddress, commitment []byte, deposit sdk.Coins) error {
+	auction, found := k.GetAuction(ctx, name)
+	if ! found {
+		return types.ErrAuctionNotFound
+	}
+
+	if auction.Type != types.AuctionTypeSealed {
+		return sdkerrors.Wrap(types.ErrInvalidAuctionType, "English auctions require an open bid.")
+	}
+
+	if ! ctx.BlockTime().Before(auction.EndTime) {
+		return types.ErrAuctionBiddingClosed
+	}
+
+	if auction.Owner.Equals(bidder) {
+		return types.ErrAlreadyOwned
+	}
+
+	if k.HasSealedBid(ctx, name, bidder) {
+		return types.ErrSealedBidExists
+	}
+
+	err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.AuctionEscrowModuleName, deposit)
+	if err != nil {
+		return err
+	}
+
+	k.SetSealedBid(ctx, types.NewSealedBid(name, bidder, commitment, deposit))
+
+	return nil
+}
+
+func (k Keeper) HandleRevealBid(ctx sdk.Context, name string, bidder sdk.AccAddress, amount sdk.Coins, salt string) error {
+	auction, found := k.GetAuction(ctx, name)
+	if ! found {
+		return types.ErrAuctionNotFound
+	}
+
+	if auction.Type != types.AuctionTypeSealed {
+		return sdkerrors.Wrap(types.ErrInvalidAuctionType, "Only sealed bids can be revealed.")
+	}
+
+	if ctx.BlockTime().Before(auction.EndTime) || ! ctx.BlockTime().Before(auction.RevealEndTime) {
+		return types.ErrAuctionRevealClosed
+	}
+
+	sealedBid, found := k.GetSealedBid(ctx, name, bidder)
+	if ! found {
+		return types.ErrSealedBidNotFound
+	}
+
+	if sealedBid.Revealed {
+		return sdkerrors.Wrap(types.ErrSealedBidMismatch, "Bid already revealed.")
+	}
+
+	if ! bytes.Equal(types.GetSealedBidCommitment(name, bidder, amount, salt), sealedBid.Commitment) {
+		return types.ErrSealedBidMismatch
+	}
+
+	if ! sealedBid.Deposit.IsAllGTE(amount) {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "Revealed bid exceeds the escrowed deposit.")
+	}
+
+	sealedBid.Revealed = true
+	sealedBid.Amount = amount
+
+	k.SetSealedBid(ctx, sealedBid)
+
+	// on equal bids the earliest reveal wins
+	if amount.IsAllGTE(auction.ReservePrice) && (! auction.HasBids() || amount.IsAllGT(auction.HighestBid)) {
+		auction.HighestBidder = bidder
+		auction.HighestBid = amount
+
+		k.SetAuction(ctx, auction)
+	}
+
 	return nil
-}
\ No newline at end of file
+}
+
+// SettleAuction pays the winning bid to the owner, hands the name over to the winner and refunds all other escrowed funds.
+func (k Keeper) SettleAuction(ctx sdk.Context, auction types.Auction) error {
+	k.RemoveFromAuctionQueue(ctx, auction.Name, auction.SettlementTime())
+	k.DeleteAuction(ctx, auction.Name)
+
+	nameInfo, found := k.GetNameInfo(ctx, auction.Name)
+
+	sold := found && auction.HasBids() && nameInfo.Owner.Equals(auction.Owner)
+
+	if sold {
+		err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.AuctionEscrowModuleName, auction.Owner, auction.HighestBid)
+		if err != nil {
+			return err
+		}
+
+		err = k.ChangeNameOwner(ctx, nameInfo, auction.HighestBidder, auction.HighestBid)
+		if err != nil {
+			return err
+		}
+	} else if auction.Type == types.AuctionTypeEnglish && auction.HasBids() {
+		err := k.RefundBid(ctx, auction.Name, auction.HighestBidder, auction.HighestBid)
+		if err != nil {
+			return err
+		}
+	}
+
+	if auction.Type == types.AuctionTypeSealed {
+		var sealedBids []types.SealedBid
+		k.IterateSealedBids(ctx, auction.Name, func(sealedBid types.SealedBid) (stop bool) {
+			sealedBids = append(sealedBids, sealedBid)
+			return false
+		})
+
+		for _, sealedBid := range sealedBids {
+			refund := sealedBid.Deposit
+			if sold && sealedBid.Bidder.Equals(auction.HighestBidder) {
+				refund = refund.Sub(auction.HighestBid)
+			}
+
+			if ! refund.IsZero() {
+				err := k.RefundBid(ctx, auction.Name, sealedBid.Bidder, refund)
+				if err != nil {
+					return err
+				}
+			}
+
+			k.DeleteSealedBid(ctx, auction.Name, sealedBid.Bidder)
+		}
+	}
+
+	winner := ""
+	amount := sdk.NewCoins()
+	if sold {
+		winner = auction.HighestBidder.String()
+		amount = auction.HighestBid
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettleAuction,
+			sdk.NewAttribute(types.AttributeKeyName, auction.Name),
+			sdk.NewAttribute(types.AttributeKeyWinner, winner),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) RefundBid(ctx sdk.Context, name string, bidder sdk.AccAddress, amount sdk.Coins) error {
+	err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.AuctionEscrowModuleName, bidder, amount)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundBid,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) GetAuction(ctx sdk.Context, name string) (types.Auction, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetAuctionKey(name))
+	if bz == nil {
+		return types.Auction{}, false
+	}
+
+	var auction types.Auction
+	k.cdc.MustUnmarshalBinaryBare(bz, &auction)
+
+	return auction, true
+}
+
+func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetAuctionKey(auction.Name), k.cdc.MustMarshalBinaryBare(auction))
+}
+
+func (k Keeper) DeleteAuction(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetAuctionKey(name))
+}
+
+func (k Keeper) HasAuction(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetAuctionKey(name))
+}
+
+func (k Keeper) GetAuctionsIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.AuctionKeyPrefix)
+}
+
+func (k Keeper) IterateAuctions(ctx sdk.Context, cb func(auction types.Auction) (stop bool)) {
+	iterator := k.GetAuctionsIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.Auction
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &auction)
+
+		if cb(auction) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetSealedBid(ctx sdk.Context, name string, bidder sdk.AccAddress) (types.SealedBid, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetSealedBidKey(name, bidder))
+	if bz == nil {
+		return types.SealedBid{}, false
+	}
+
+	var sealedBid types.SealedBid
+	k.cdc.MustUnmarshalBinaryBare(bz, &sealedBid)
+
+	return sealedBid, true
+}
+
+func (k Keeper) SetSealedBid(ctx sdk.Context, sealedBid types.SealedBid) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetSealedBidKey(sealedBid.Name, sealedBid.Bidder), k.cdc.MustMarshalBinaryBare(sealedBid))
+}
+
+func (k Keeper) DeleteSealedBid(ctx sdk.Context, name string, bidder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetSealedBidKey(name, bidder))
+}
+
+func (k Keeper) HasSealedBid(ctx sdk.Context, name string, bidder sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetSealedBidKey(name, bidder))
+}
+
+func (k Keeper) IterateSealedBids(ctx sdk.Context, name string, cb func(sealedBid types.SealedBid) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetSealedBidIteratorKey(name))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sealedBid types.SealedBid
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &sealedBid)
+
+		if cb(sealedBid) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAllSealedBids(ctx sdk.Context, cb func(sealedBid types.SealedBid) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SealedBidKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sealedBid types.SealedBid
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &sealedBid)
+
+		if cb(sealedBid) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAuctionQueue(ctx sdk.Context, endTime time.Time, cb func(name string) (stop bool)) {
+	iterator := k.AuctionQueueIterator(ctx, endTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		name, _ := types.SplitAuctionQueueKey(iterator.Key())
+
+		if cb(name) {
+			break
+		}
+	}
+}
+
+func (k Keeper) InsertAuctionQueue(ctx sdk.Context, name string, endTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AuctionQueueKey(name, endTime), []byte(name))
+}
+
+func (k Keeper) RemoveFromAuctionQueue(ctx sdk.Context, name string, endTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AuctionQueueKey(name, endTime))
+}
+
+func (k Keeper) AuctionQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.AuctionQueueKeyPrefix, sdk.PrefixEndBytes(types.AuctionByTimeKey(endTime)))
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func TestEnglishAuctionRefundsOutbidAndSettlesToHighestBidder(t *testing.T) {
+	input := createTestInput(t)
+	owner, alice, bob := testAddrs[0], testAddrs[1], testAddrs[2]
+
+	registerName(t, input, "auctioned", owner)
+	require.NoError(t, input.keeper.HandleOpenAuction(input.ctx, "auctioned", owner, types.AuctionTypeEnglish, pin(100), time.Hour))
+
+	fund(t, input, alice, pin(1000))
+	fund(t, input, bob, pin(1000))
+
+	require.Equal(t, types.ErrBidTooLow, input.keeper.HandlePlaceBid(input.ctx, "auctioned", alice, pin(99)))
+	require.NoError(t, input.keeper.HandlePlaceBid(input.ctx, "auctioned", alice, pin(200)))
+	require.Equal(t, types.ErrBidTooLow, input.keeper.HandlePlaceBid(input.ctx, "auctioned", bob, pin(200)))
+	require.NoError(t, input.keeper.HandlePlaceBid(input.ctx, "auctioned", bob, pin(300)))
+
+	// the outbid funds are refunded right away, only the highest bid stays in escrow
+	require.Equal(t, pin(1000), input.bankKeeper.GetCoins(input.ctx, alice))
+	require.Equal(t, pin(300), input.supplyKeeper.GetModuleAccount(input.ctx, types.AuctionEscrowModuleName).GetCoins())
+
+	ownerBalance := input.bankKeeper.GetCoins(input.ctx, owner)
+
+	ctx := input.ctx.WithBlockTime(input.ctx.BlockTime().Add(time.Hour))
+	require.Equal(t, types.ErrAuctionBiddingClosed, input.keeper.HandlePlaceBid(ctx, "auctioned", alice, pin(400)))
+
+	auction, found := input.keeper.GetAuction(ctx, "auctioned")
+	require.True(t, found)
+	require.NoError(t, input.keeper.SettleAuction(ctx, auction))
+
+	nameInfo, _ := input.keeper.GetNameInfo(ctx, "auctioned")
+	require.Equal(t, bob, nameInfo.Owner)
+	require.Equal(t, ownerBalance.Add(pin(300)...), input.bankKeeper.GetCoins(ctx, owner))
+	require.True(t, input.supplyKeeper.GetModuleAccount(ctx, types.AuctionEscrowModuleName).GetCoins().IsZero())
+	require.False(t, input.keeper.HasAuction(ctx, "auctioned"))
+}
+
+func TestSealedAuctionRefundsLosersAndExcessDeposit(t *testing.T) {
+	input := createTestInput(t)
+	owner, alice, bob, carol := testAddrs[0], testAddrs[1], testAddrs[2], testAddrs[3]
+
+	registerName(t, input, "sealed", owner)
+	require.NoError(t, input.keeper.HandleOpenAuction(input.ctx, "sealed", owner, types.AuctionTypeSealed, pin(100), time.Hour))
+
+	for _, bidder := range []struct {
+		address sdk.AccAddress
+		amount  int64
+		deposit int64
+	}{
+		{alice, 200, 500},
+		{bob, 300, 400},
+		{carol, 900, 1000},
+	} {
+		fund(t, input, bidder.address, pin(bidder.deposit))
+		commitment := types.GetSealedBidCommitment("sealed", bidder.address, pin(bidder.amount), "salt")
+		require.NoError(t, input.keeper.HandleCommitBid(input.ctx, "sealed", bidder.address, commitment, pin(bidder.deposit)))
+	}
+
+	require.Equal(t, types.ErrAuctionRevealClosed, input.keeper.HandleRevealBid(input.ctx, "sealed", alice, pin(200), "salt"))
+
+	ctx := input.ctx.WithBlockTime(input.ctx.BlockTime().Add(time.Hour))
+	require.Equal(t, types.ErrSealedBidMismatch, input.keeper.HandleRevealBid(ctx, "sealed", alice, pin(250), "salt"))
+	require.NoError(t, input.keeper.HandleRevealBid(ctx, "sealed", alice, pin(200), "salt"))
+	require.NoError(t, input.keeper.HandleRevealBid(ctx, "sealed", bob, pin(300), "salt"))
+	// carol never reveals and only gets her deposit back
+
+	ownerBalance := input.bankKeeper.GetCoins(ctx, owner)
+
+	auction, _ := input.keeper.GetAuction(ctx, "sealed")
+	require.Equal(t, bob, auction.HighestBidder)
+
+	ctx = ctx.WithBlockTime(auction.SettlementTime())
+	require.NoError(t, input.keeper.SettleAuction(ctx, auction))
+
+	nameInfo, _ := input.keeper.GetNameInfo(ctx, "sealed")
+	require.Equal(t, bob, nameInfo.Owner)
+	require.Equal(t, ownerBalance.Add(pin(300)...), input.bankKeeper.GetCoins(ctx, owner))
+	require.Equal(t, pin(500), input.bankKeeper.GetCoins(ctx, alice))
+	require.Equal(t, pin(100), input.bankKeeper.GetCoins(ctx, bob))
+	require.Equal(t, pin(1000), input.bankKeeper.GetCoins(ctx, carol))
+	require.True(t, input.supplyKeeper.GetModuleAccount(ctx, types.AuctionEscrowModuleName).GetCoins().IsZero())
+	require.False(t, input.keeper.HasSealedBid(ctx, "sealed", carol))
+}
+
+func TestAuctionWithoutBidsKeepsOwner(t *testing.T) {
+	input := createTestInput(t)
+	owner := testAddrs[0]
+
+	registerName(t, input, "unsold", owner)
+	require.NoError(t, input.keeper.HandleOpenAuction(input.ctx, "unsold", owner, types.AuctionTypeEnglish, pin(100), time.Hour))
+	require.Equal(t, types.ErrNameInAuction, input.keeper.HandleOpenAuction(input.ctx, "unsold", owner, types.AuctionTypeEnglish, pin(100), time.Hour))
+
+	auction, _ := input.keeper.GetAuction(input.ctx, "unsold")
+	require.NoError(t, input.keeper.SettleAuction(input.ctx.WithBlockTime(auction.SettlementTime()), auction))
+
+	nameInfo, _ := input.keeper.GetNameInfo(input.ctx, "unsold")
+	require.Equal(t, owner, nameInfo.Owner)
+	require.False(t, input.keeper.HasAuction(input.ctx, "unsold"))
+}
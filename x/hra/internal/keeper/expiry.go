@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/anathatech/project-anatha/x/hra/internal/types"
 	"time"
 )
@@ -31,8 +32,36 @@ func (keeper Keeper) RemoveFromExpiredNameInfoQueue(ctx sdk.Context, name string
 }
 
 func (k Keeper) DeleteExpiredNameInfo(ctx sdk.Context, nameInfo types.NameInfo) error {
+	err := k.releaseName(ctx, nameInfo)
+	if err != nil {
+		return err
+	}
+
+	k.AppendNameHistory(ctx, nameInfo, types.HistoryEventExpire, nil, nil)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExpiredName,
+			sdk.NewAttribute(types.AttributeKeyName, nameInfo.Name),
+			sdk.NewAttribute(types.AttributeKeySender, nameInfo.Owner.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	return nil
+}
+
+// releaseName removes a top level name together with everything attached to it, and releases the owner's
+// addresses, credits and hooks if it was their last name.
+func (k Keeper) releaseName(ctx sdk.Context, nameInfo types.NameInfo) error {
 	k.DeleteNameInfo(ctx, nameInfo.Name)
 	k.DeleteNameInfoStatusMap(ctx, nameInfo.Owner, nameInfo.Name)
+	k.ClearPendingTransfer(ctx, nameInfo.Name)
+	k.DeleteSubnames(ctx, nameInfo.Name)
+	k.ClearPrimaryName(ctx, nameInfo.Owner, nameInfo.Name)
+	k.RemoveAllTextRecords(ctx, nameInfo.Name)
+	k.RemoveAllNameAddresses(ctx, nameInfo.Name)
+	k.RemoveAllOperators(ctx, nameInfo.Name)
 
 	// if last HRA remove all associated addresses
 	if ! k.OwnsAnyName(ctx, nameInfo.Owner) {
@@ -46,15 +75,6 @@ func (k Keeper) DeleteExpiredNameInfo(ctx sdk.Context, nameInfo types.NameInfo)
 
 	k.RemoveFromExpiredNameInfoQueue(ctx, nameInfo.Name, nameInfo.ExpiryTime)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeExpiredName,
-			sdk.NewAttribute(types.AttributeKeyName, nameInfo.Name),
-			sdk.NewAttribute(types.AttributeKeySender, nameInfo.Owner.String()),
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
-		),
-	)
-
 	return nil
 }
 
@@ -62,3 +82,116 @@ func (keeper Keeper) ExpiredNameInfoQueueIterator(ctx sdk.Context, endTime time.
 	store := ctx.KVStore(keeper.storeKey)
 	return store.Iterator(types.ExpiredNameInfoQueueKeyPrefix, sdk.PrefixEndBytes(types.ExpiredNameInfoByTimeKey(endTime)))
 }
+
+// ExpiredNameInfoQueueRangeIterator returns an iterator through the names expiring between startTime and endTime, both inclusive
+func (keeper Keeper) ExpiredNameInfoQueueRangeIterator(ctx sdk.Context, startTime time.Time, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.ExpiredNameInfoByTimeKey(startTime), sdk.PrefixEndBytes(types.ExpiredNameInfoByTimeKey(endTime)))
+}
+
+// GraceEndTime returns the end of the period in which an expired name still resolves and can be renewed at the regular fee.
+func (k Keeper) GraceEndTime(ctx sdk.Context, nameInfo types.NameInfo) time.Time {
+	return nameInfo.ExpiryTime.Add(k.NameInfoGracePeriod(ctx))
+}
+
+// RedemptionEndTime returns the time at which an expired name is released back into the pool.
+func (k Keeper) RedemptionEndTime(ctx sdk.Context, nameInfo types.NameInfo) time.Time {
+	return k.GraceEndTime(ctx, nameInfo).Add(k.NameInfoRedemptionPeriod(ctx))
+}
+
+func (k Keeper) GetNameStatus(ctx sdk.Context, nameInfo types.NameInfo) string {
+	if ctx.BlockTime().Before(nameInfo.ExpiryTime) {
+		return types.NameStatusActive
+	}
+
+	if ctx.BlockTime().Before(k.GraceEndTime(ctx, nameInfo)) {
+		return types.NameStatusGrace
+	}
+
+	return types.NameStatusRedemption
+}
+
+func (k Keeper) IsNameExpired(ctx sdk.Context, nameInfo types.NameInfo) bool {
+	return ! ctx.BlockTime().Before(nameInfo.ExpiryTime)
+}
+
+// GetRenewalFee returns the fee for renewing the name by the given number of periods.
+// During the redemption period the first period is charged at the redemption fee.
+func (k Keeper) GetRenewalFee(ctx sdk.Context, name string, periods uint64) (sdk.Coins, error) {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return nil, types.ErrNameNotRegistered
+	}
+
+	if err := checkPeriods(periods); err != nil {
+		return nil, err
+	}
+
+	renewalFee := k.NameInfoRenewalFee(ctx)
+
+	if k.GetNameStatus(ctx, nameInfo) == types.NameStatusRedemption {
+		return k.NameInfoRedemptionFee(ctx).Add(multiplyFee(renewalFee, periods - 1)...), nil
+	}
+
+	return multiplyFee(renewalFee, periods), nil
+}
+
+// GetRegistrationFee returns the fee for registering a name for the given number of periods, priced by the
+// pricing schedule. It is used both when charging the fee and when quoting it.
+func (k Keeper) GetRegistrationFee(ctx sdk.Context, name string, periods uint64) (sdk.Coins, error) {
+	if err := checkPeriods(periods); err != nil {
+		return nil, err
+	}
+
+	fee := k.NameInfoPricingSchedule(ctx).GetFee(name, k.NameInfoRegistrationFee(ctx))
+
+	return multiplyFee(fee, periods), nil
+}
+
+// GetAvailablePeriods returns how many periods can still be bought for a name expiring at expiryTime
+// without exceeding the maximum duration.
+func (k Keeper) GetAvailablePeriods(ctx sdk.Context, expiryTime time.Time) uint64 {
+	remaining := ctx.BlockTime().Add(k.NameInfoMaxDuration(ctx)).Sub(expiryTime)
+	if remaining <= 0 {
+		return 0
+	}
+
+	return uint64(remaining / k.NameInfoDuration(ctx))
+}
+
+func (k Keeper) validatePeriods(ctx sdk.Context, expiryTime time.Time, periods uint64) error {
+	if err := checkPeriods(periods); err != nil {
+		return err
+	}
+
+	available := k.GetAvailablePeriods(ctx, expiryTime)
+
+	if periods > available {
+		return sdkerrors.Wrapf(types.ErrMaximumDurationExceeded, "%d periods requested, at most %d more can be bought", periods, available)
+	}
+
+	return nil
+}
+
+// checkPeriods rejects zero periods and more periods than a message can carry before any fee is multiplied
+func checkPeriods(periods uint64) error {
+	if periods == 0 {
+		return types.ErrInvalidPeriods
+	}
+
+	if periods > types.MaxPeriods {
+		return sdkerrors.Wrapf(types.ErrInvalidPeriods, "at most %d periods can be bought at once", types.MaxPeriods)
+	}
+
+	return nil
+}
+
+func multiplyFee(fee sdk.Coins, periods uint64) sdk.Coins {
+	result := sdk.NewCoins()
+
+	for _, coin := range fee {
+		result = result.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(periods))))
+	}
+
+	return result
+}
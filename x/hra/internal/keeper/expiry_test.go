@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func TestRenewalFeeInGraceAndRedemptionPeriods(t *testing.T) {
+	input := createTestInput(t)
+	owner := testAddrs[0]
+
+	nameInfo := registerName(t, input, "expiring", owner)
+
+	renewalFee := input.keeper.NameInfoRenewalFee(input.ctx)
+	redemptionFee := input.keeper.NameInfoRedemptionFee(input.ctx)
+
+	grace := input.ctx.WithBlockTime(nameInfo.ExpiryTime)
+	require.Equal(t, types.NameStatusGrace, input.keeper.GetNameStatus(grace, nameInfo))
+
+	fee, err := input.keeper.GetRenewalFee(grace, "expiring", 2)
+	require.NoError(t, err)
+	require.Equal(t, multiplyFee(renewalFee, 2), fee)
+
+	redemption := input.ctx.WithBlockTime(input.keeper.GraceEndTime(input.ctx, nameInfo))
+	require.Equal(t, types.NameStatusRedemption, input.keeper.GetNameStatus(redemption, nameInfo))
+
+	// the first period is charged at the redemption fee
+	fee, err = input.keeper.GetRenewalFee(redemption, "expiring", 2)
+	require.NoError(t, err)
+	require.Equal(t, redemptionFee.Add(renewalFee...), fee)
+
+	fund(t, input, owner, fee)
+	require.NoError(t, input.keeper.HandleRenewName(redemption, "expiring", owner, 2))
+	require.True(t, input.bankKeeper.GetCoins(redemption, owner).IsZero())
+
+	renewed, _ := input.keeper.GetNameInfo(redemption, "expiring")
+	require.Equal(t, nameInfo.ExpiryTime.Add(2 * input.keeper.NameInfoDuration(input.ctx)), renewed.ExpiryTime)
+	require.Equal(t, types.NameStatusActive, input.keeper.GetNameStatus(redemption, renewed))
+}
+
+func TestRenewalClosesWithRedemptionPeriod(t *testing.T) {
+	input := createTestInput(t)
+	owner := testAddrs[0]
+
+	nameInfo := registerName(t, input, "released", owner)
+
+	released := input.ctx.WithBlockTime(input.keeper.RedemptionEndTime(input.ctx, nameInfo))
+
+	fund(t, input, owner, input.keeper.NameInfoRedemptionFee(input.ctx))
+	require.Equal(t, types.ErrExpiredNameRenewal, input.keeper.HandleRenewName(released, "released", owner, 1))
+
+	require.NoError(t, input.keeper.DeleteExpiredNameInfo(released, nameInfo))
+	require.False(t, input.keeper.IsNameRegistered(released, "released"))
+}
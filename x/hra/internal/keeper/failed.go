@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+// FailQueueItem takes an item the end blocker failed to process out of its queue and moves it to the failed set, so
+// that it is only processed again when it is retried.
+func (k Keeper) FailQueueItem(ctx sdk.Context, item types.FailedQueueItem) {
+	switch item.Action {
+	case types.EventTypeSettleAuction:
+		if auction, found := k.GetAuction(ctx, item.Name); found {
+			k.RemoveFromAuctionQueue(ctx, auction.Name, auction.SettlementTime())
+		}
+
+	case types.EventTypeExpireOffer:
+		if offer, found := k.GetOffer(ctx, item.Name, item.Bidder); found {
+			k.RemoveFromOfferQueue(ctx, offer)
+		}
+
+	case types.EventTypeCompleteTransfer:
+		if transfer, found := k.GetPendingTransfer(ctx, item.Name); found {
+			k.RemoveFromPendingTransferQueue(ctx, transfer)
+		}
+
+	case types.EventTypeExpiredName:
+		if nameInfo, found := k.GetNameInfo(ctx, item.Name); found {
+			k.RemoveFromExpiredNameInfoQueue(ctx, nameInfo.Name, nameInfo.ExpiryTime)
+		}
+	}
+
+	k.SetFailedQueueItem(ctx, item)
+}
+
+// HandleRetryFailedQueueItem processes a failed item again, the item stays in the failed set if it fails again. An
+// item whose auction, offer, transfer or name is gone by now is dropped from the failed set.
+func (k Keeper) HandleRetryFailedQueueItem(ctx sdk.Context, action string, name string, bidder sdk.AccAddress) error {
+	item, found := k.GetFailedQueueItem(ctx, action, name, bidder)
+	if ! found {
+		return types.ErrFailedQueueItemNotFound
+	}
+
+	err := k.processFailedQueueItem(ctx, item)
+	if err != nil {
+		return err
+	}
+
+	k.RemoveFailedQueueItem(ctx, action, name, bidder)
+
+	return nil
+}
+
+func (k Keeper) processFailedQueueItem(ctx sdk.Context, item types.FailedQueueItem) error {
+	switch item.Action {
+	case types.EventTypeSettleAuction:
+		auction, found := k.GetAuction(ctx, item.Name)
+		if ! found {
+			return nil
+		}
+
+		return k.SettleAuction(ctx, auction)
+
+	case types.EventTypeExpireOffer:
+		offer, found := k.GetOffer(ctx, item.Name, item.Bidder)
+		if ! found {
+			return nil
+		}
+
+		return k.ExpireOffer(ctx, offer)
+
+	case types.EventTypeCompleteTransfer:
+		transfer, found := k.GetPendingTransfer(ctx, item.Name)
+		if ! found {
+			return nil
+		}
+
+		return k.CompletePendingTransfer(ctx, transfer)
+
+	case types.EventTypeExpiredName:
+		nameInfo, found := k.GetNameInfo(ctx, item.Name)
+		if ! found {
+			return nil
+		}
+
+		return k.DeleteExpiredNameInfo(ctx, nameInfo)
+
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Unknown queue action: %s", item.Action)
+	}
+}
+
+func (k Keeper) GetFailedQueueItem(ctx sdk.Context, action string, name string, bidder sdk.AccAddress) (types.FailedQueueItem, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetFailedQueueItemKey(action, name, bidder))
+	if bz == nil {
+		return types.FailedQueueItem{}, false
+	}
+
+	var item types.FailedQueueItem
+	k.cdc.MustUnmarshalBinaryBare(bz, &item)
+
+	return item, true
+}
+
+func (k Keeper) SetFailedQueueItem(ctx sdk.Context, item types.FailedQueueItem) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetFailedQueueItemKey(item.Action, item.Name, item.Bidder), k.cdc.MustMarshalBinaryBare(item))
+}
+
+func (k Keeper) RemoveFailedQueueItem(ctx sdk.Context, action string, name string, bidder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetFailedQueueItemKey(action, name, bidder))
+}
+
+func (k Keeper) IterateFailedQueueItems(ctx sdk.Context, cb func(item types.FailedQueueItem) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.FailedQueueItemKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var item types.FailedQueueItem
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &item)
+
+		if cb(item) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetFailedQueueItems(ctx sdk.Context) []types.FailedQueueItem {
+	items := []types.FailedQueueItem{}
+	k.IterateFailedQueueItems(ctx, func(item types.FailedQueueItem) (stop bool) {
+		items = append(items, item)
+		return false
+	})
+
+	return items
+}
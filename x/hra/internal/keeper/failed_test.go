@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func countOfferQueue(ctx sdk.Context, k Keeper) int {
+	count := 0
+	k.IterateOfferQueue(ctx, ctx.BlockTime(), func(name string, bidder sdk.AccAddress) (stop bool) {
+		count++
+		return false
+	})
+
+	return count
+}
+
+func TestFailedQueueItemLeavesQueueUntilRetried(t *testing.T) {
+	input := createTestInput(t)
+	owner, alice := testAddrs[0], testAddrs[1]
+
+	registerName(t, input, "stuck", owner)
+	fund(t, input, alice, pin(1000))
+
+	require.NoError(t, input.keeper.HandlePlaceOffer(input.ctx, "stuck", alice, pin(300), time.Hour))
+
+	expired := input.ctx.WithBlockTime(input.ctx.BlockTime().Add(time.Hour))
+	require.Equal(t, 1, countOfferQueue(expired, input.keeper))
+
+	// the escrow can no longer refund the offer
+	require.NoError(t, input.supplyKeeper.SendCoinsFromModuleToAccount(expired, types.OfferEscrowModuleName, owner, pin(300)))
+
+	offer, found := input.keeper.GetOffer(expired, "stuck", alice)
+	require.True(t, found)
+
+	cacheCtx, _ := expired.CacheContext()
+	err := input.keeper.ExpireOffer(cacheCtx, offer)
+	require.Error(t, err)
+
+	input.keeper.FailQueueItem(expired, types.NewFailedQueueItem(types.EventTypeExpireOffer, "stuck", alice, err.Error(), expired.BlockTime()))
+
+	require.Equal(t, 0, countOfferQueue(expired, input.keeper))
+	require.True(t, input.keeper.HasOffer(expired, "stuck", alice))
+	require.Len(t, input.keeper.GetFailedQueueItems(expired), 1)
+
+	// a retry that fails again keeps the item in the failed set
+	cacheCtx, _ = expired.CacheContext()
+	require.Error(t, input.keeper.HandleRetryFailedQueueItem(cacheCtx, types.EventTypeExpireOffer, "stuck", alice))
+	require.Len(t, input.keeper.GetFailedQueueItems(expired), 1)
+
+	require.NoError(t, input.supplyKeeper.SendCoinsFromAccountToModule(expired, owner, types.OfferEscrowModuleName, pin(300)))
+	require.NoError(t, input.keeper.HandleRetryFailedQueueItem(expired, types.EventTypeExpireOffer, "stuck", alice))
+
+	require.False(t, input.keeper.HasOffer(expired, "stuck", alice))
+	require.Equal(t, pin(1000), input.bankKeeper.GetCoins(expired, alice))
+	require.Empty(t, input.keeper.GetFailedQueueItems(expired))
+
+	require.Equal(t, types.ErrFailedQueueItemNotFound, input.keeper.HandleRetryFailedQueueItem(expired, types.EventTypeExpireOffer, "stuck", alice))
+}
+
+func TestFailedQueueItemWithoutRecordIsDropped(t *testing.T) {
+	input := createTestInput(t)
+
+	input.keeper.FailQueueItem(input.ctx, types.NewFailedQueueItem(types.EventTypeCompleteTransfer, "gone", nil, "failed", input.ctx.BlockTime()))
+	require.Len(t, input.keeper.GetFailedQueueItems(input.ctx), 1)
+
+	require.NoError(t, input.keeper.HandleRetryFailedQueueItem(input.ctx, types.EventTypeCompleteTransfer, "gone", nil))
+	require.Empty(t, input.keeper.GetFailedQueueItems(input.ctx))
+}
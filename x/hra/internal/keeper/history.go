@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"encoding/binary"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+// AppendNameHistory records a change of the name and prunes the entries that fall outside of the retention limit.
+func (k Keeper) AppendNameHistory(ctx sdk.Context, nameInfo types.NameInfo, event string, previousOwner sdk.AccAddress, price sdk.Coins) {
+	sequence := k.GetNameHistorySequence(ctx, nameInfo.Name)
+
+	entry := types.NewNameHistoryEntry(
+		nameInfo.Name,
+		sequence,
+		event,
+		nameInfo.Owner,
+		previousOwner,
+		price,
+		nameInfo.ExpiryTime,
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+	)
+
+	k.SetNameHistoryEntry(ctx, entry)
+	k.pruneNameHistory(ctx, nameInfo.Name, sequence + 1)
+}
+
+// pruneNameHistory removes all entries older than the last retained ones. Lowering the retention through governance
+// takes effect the next time the name changes.
+func (k Keeper) pruneNameHistory(ctx sdk.Context, name string, next uint64) {
+	retention := k.NameHistoryRetention(ctx)
+	if next <= retention {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.GetNameHistoryIteratorKey(name), types.GetNameHistoryKey(name, next - retention))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) GetNameHistorySequence(ctx sdk.Context, name string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetNameHistorySequenceKey(name))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNameHistoryEntry stores the entry and moves the sequence of the name past it.
+func (k Keeper) SetNameHistoryEntry(ctx sdk.Context, entry types.NameHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetNameHistoryKey(entry.Name, entry.Sequence), k.cdc.MustMarshalBinaryBare(entry))
+
+	if entry.Sequence >= k.GetNameHistorySequence(ctx, entry.Name) {
+		store.Set(types.GetNameHistorySequenceKey(entry.Name), sdk.Uint64ToBigEndian(entry.Sequence + 1))
+	}
+}
+
+// IterateNameHistory goes through the history of a name starting with the most recent entry.
+func (k Keeper) IterateNameHistory(ctx sdk.Context, name string, cb func(entry types.NameHistoryEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetNameHistoryIteratorKey(name))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.NameHistoryEntry
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &entry)
+
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAllNameHistory(ctx sdk.Context, cb func(entry types.NameHistoryEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.NameHistoryKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.NameHistoryEntry
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &entry)
+
+		if cb(entry) {
+			break
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func getNameHistory(input testInput, name string) []types.NameHistoryEntry {
+	var entries []types.NameHistoryEntry
+
+	input.keeper.IterateNameHistory(input.ctx, name, func(entry types.NameHistoryEntry) (stop bool) {
+		entries = append(entries, entry)
+		return false
+	})
+
+	return entries
+}
+
+func TestNameHistoryRecordsOwnershipChanges(t *testing.T) {
+	input := createTestInput(t)
+	owner, buyer := testAddrs[0], testAddrs[1]
+
+	registerName(t, input, "history", owner)
+
+	require.NoError(t, input.keeper.HandleSetPrice(input.ctx, "history", owner, pin(100)))
+
+	fund(t, input, buyer, pin(100))
+	require.NoError(t, input.keeper.HandleBuyName(input.ctx, "history", buyer))
+
+	// the most recent entry comes first
+	entries := getNameHistory(input, "history")
+	require.Len(t, entries, 3)
+
+	require.Equal(t, types.HistoryEventSale, entries[0].Event)
+	require.Equal(t, uint64(2), entries[0].Sequence)
+	require.Equal(t, buyer, entries[0].Owner)
+	require.Equal(t, owner, entries[0].PreviousOwner)
+	require.Equal(t, pin(100), entries[0].Price)
+
+	require.Equal(t, types.HistoryEventSetPrice, entries[1].Event)
+	require.Equal(t, types.HistoryEventRegister, entries[2].Event)
+	require.Equal(t, owner, entries[2].Owner)
+}
+
+func TestNameHistoryIsPrunedToRetention(t *testing.T) {
+	input := createTestInput(t)
+	owner := testAddrs[0]
+
+	input.keeper.SetNameHistoryRetention(input.ctx, 2)
+
+	registerName(t, input, "pruned", owner)
+
+	for _, price := range []int64{100, 200, 300} {
+		require.NoError(t, input.keeper.HandleSetPrice(input.ctx, "pruned", owner, pin(price)))
+	}
+
+	entries := getNameHistory(input, "pruned")
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(3), entries[0].Sequence)
+	require.Equal(t, pin(300), entries[0].Price)
+	require.Equal(t, uint64(2), entries[1].Sequence)
+	require.Equal(t, uint64(4), input.keeper.GetNameHistorySequence(input.ctx, "pruned"))
+}
@@ -24,12 +24,20 @@ func (k Keeper) SetNameInfo(ctx sdk.Context, name string, nameInfo types.NameInf
 	store := ctx.KVStore(k.storeKey)
 
 	store.Set(types.GetNameInfoByNameKey(name), k.cdc.MustMarshalBinaryBare(nameInfo))
+
+	// names with a price are indexed so that they can be listed without going through every name
+	if nameInfo.Price.IsZero() {
+		store.Delete(types.GetNameForSaleKey(name))
+	} else {
+		store.Set(types.GetNameForSaleKey(name), types.StatusPresent)
+	}
 }
 
 func (k Keeper) DeleteNameInfo(ctx sdk.Context, name string) {
 	store := ctx.KVStore(k.storeKey)
 
 	store.Delete(types.GetNameInfoByNameKey(name))
+	store.Delete(types.GetNameForSaleKey(name))
 }
 
 func (k Keeper) DeleteNameInfoStatusMap(ctx sdk.Context, owner sdk.AccAddress, name string) {
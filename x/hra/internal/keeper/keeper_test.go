@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/anathatech/project-anatha/config"
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+var (
+	testAddrs = []sdk.AccAddress{
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	}
+)
+
+func init() {
+	// the denoms registered by the app, the default params are converted from anatha
+	_ = sdk.RegisterDenom("anatha", sdk.OneDec())
+	_ = sdk.RegisterDenom("pin", sdk.NewDecWithPrec(1, 8))
+	_ = sdk.RegisterDenom("usd", sdk.OneDec())
+	_ = sdk.RegisterDenom("din", sdk.NewDecWithPrec(1, 10))
+}
+
+type testInput struct {
+	ctx          sdk.Context
+	keeper       Keeper
+	bankKeeper   bank.Keeper
+	supplyKeeper supply.Keeper
+}
+
+func makeTestCodec() *codec.Codec {
+	cdc := codec.New()
+
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	return cdc
+}
+
+// createTestInput sets up the hra module with the default params and no registered names.
+func createTestInput(t *testing.T) testInput {
+	keyHra := sdk.NewKVStoreKey(types.StoreKey)
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+
+	ms.MountStoreWithDB(keyHra, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := makeTestCodec()
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "hra-test", Time: time.Unix(1600000000, 0).UTC()}, false, log.NewNopLogger())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), map[string]bool{})
+
+	maccPerms := map[string][]string{
+		auth.FeeCollectorName:         nil,
+		types.AuctionEscrowModuleName: nil,
+		types.OfferEscrowModuleName:   nil,
+	}
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+
+	keeper := NewKeeper(bankKeeper, accountKeeper, supplyKeeper, cdc, keyHra, pk.Subspace(types.DefaultParamspace), auth.FeeCollectorName)
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	return testInput{
+		ctx:          ctx,
+		keeper:       keeper,
+		bankKeeper:   bankKeeper,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+func pin(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, amount))
+}
+
+// fund adds coins to an account, creating the account if needed.
+func fund(t *testing.T, input testInput, address sdk.AccAddress, amount sdk.Coins) {
+	_, err := input.bankKeeper.AddCoins(input.ctx, address, amount)
+	require.NoError(t, err)
+}
+
+// registerName registers the name for a single period, funding the owner with the registration fee first.
+func registerName(t *testing.T, input testInput, name string, owner sdk.AccAddress) types.NameInfo {
+	fee, err := input.keeper.GetRegistrationFee(input.ctx, name, 1)
+	require.NoError(t, err)
+
+	fund(t, input, owner, fee)
+	require.NoError(t, input.keeper.HandleRegisterName(input.ctx, name, owner, 1))
+
+	nameInfo, found := input.keeper.GetNameInfo(input.ctx, name)
+	require.True(t, found)
+
+	return nameInfo
+}
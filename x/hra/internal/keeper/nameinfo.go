@@ -5,19 +5,39 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/anathatech/project-anatha/config"
 	"github.com/anathatech/project-anatha/x/hra/internal/types"
+	"time"
 )
 
 
-func (k Keeper) HandleRegisterName(ctx sdk.Context, name string, owner sdk.AccAddress) error {
+func (k Keeper) HandleRegisterName(ctx sdk.Context, name string, owner sdk.AccAddress, periods uint64) error {
 	if k.IsNameRegistered(ctx, name) {
 		return types.ErrNameRegistered
 	}
 
-	err := k.SupplyKeeper.SendCoinsFromAccountToModule(
+	if k.IsNameReserved(ctx, name) {
+		return types.ErrNameReserved
+	}
+
+	// names beneath another name are only created through HandleRegisterSubname
+	if err := types.ValidateTopLevelName(name); err != nil {
+		return err
+	}
+
+	err := k.validatePeriods(ctx, ctx.BlockTime(), periods)
+	if err != nil {
+		return err
+	}
+
+	fee, err := k.GetRegistrationFee(ctx, name, periods)
+	if err != nil {
+		return err
+	}
+
+	err = k.SupplyKeeper.SendCoinsFromAccountToModule(
 		ctx,
 		owner,
 		k.feeCollectorName,
-		k.NameInfoRegistrationFee(ctx),
+		fee,
 	)
 	if err != nil {
 		return err
@@ -28,13 +48,13 @@ func (k Keeper) HandleRegisterName(ctx sdk.Context, name string, owner sdk.AccAd
 		k.AfterFirstNameCreated(ctx, owner)
 	}
 
-	k.RegisterName(ctx, name, owner)
+	nameInfo := k.RegisterName(ctx, name, owner, periods)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeRegister,
 			sdk.NewAttribute(types.AttributeKeyName, name),
-			sdk.NewAttribute(types.AttributeKeyExpires, ctx.BlockTime().Add(k.NameInfoDuration(ctx)).String()),
+			sdk.NewAttribute(types.AttributeKeyExpires, nameInfo.ExpiryTime.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -46,34 +66,57 @@ func (k Keeper) HandleRegisterName(ctx sdk.Context, name string, owner sdk.AccAd
 	return nil
 }
 
-func (k Keeper) RegisterName(ctx sdk.Context, name string, owner sdk.AccAddress) {
+func (k Keeper) RegisterName(ctx sdk.Context, name string, owner sdk.AccAddress, periods uint64) types.NameInfo {
 	nameInfo := types.NewNameInfo(name)
 
 	nameInfo.Owner = owner
 	nameInfo.CreationTime = ctx.BlockTime()
-	nameInfo.ExpiryTime = nameInfo.CreationTime.Add(k.NameInfoDuration(ctx))
+	nameInfo.ExpiryTime = nameInfo.CreationTime.Add(time.Duration(periods) * k.NameInfoDuration(ctx))
 
 	k.SetNameInfo(ctx, name, nameInfo)
 	k.SetNameInfoStatusMap(ctx, owner, name)
 
 	k.InsertExpiredNameInfoQueue(ctx, name, nameInfo.ExpiryTime)
+
+	k.AppendNameHistory(ctx, nameInfo, types.HistoryEventRegister, nil, nil)
+
+	return nameInfo
 }
 
-func (k Keeper) HandleRenewName(ctx sdk.Context, name string, owner sdk.AccAddress) error {
+func (k Keeper) HandleRenewName(ctx sdk.Context, name string, owner sdk.AccAddress, periods uint64) error {
 	nameInfo, found := k.GetNameInfo(ctx, name)
 	if ! found {
 		return types.ErrNameNotRegistered
 	}
 
-	if ! owner.Equals(nameInfo.Owner) {
+	if nameInfo.IsSubname() {
+		return types.ErrSubnameNotAllowed
+	}
+
+	if ! k.IsOwnerOrOperator(ctx, nameInfo, owner) {
 		return types.ErrNotOwner
 	}
 
-	err := k.SupplyKeeper.SendCoinsFromAccountToModule(
+	// names can be renewed until the end of the redemption period
+	if ! ctx.BlockTime().Before(k.RedemptionEndTime(ctx, nameInfo)) {
+		return types.ErrExpiredNameRenewal
+	}
+
+	err := k.validatePeriods(ctx, nameInfo.ExpiryTime, periods)
+	if err != nil {
+		return err
+	}
+
+	fee, err := k.GetRenewalFee(ctx, name, periods)
+	if err != nil {
+		return err
+	}
+
+	err = k.SupplyKeeper.SendCoinsFromAccountToModule(
 		ctx,
 		owner,
 		k.feeCollectorName,
-		k.NameInfoRenewalFee(ctx),
+		fee,
 	)
 	if err != nil {
 		return err
@@ -81,21 +124,15 @@ func (k Keeper) HandleRenewName(ctx sdk.Context, name string, owner sdk.AccAddre
 
 	oldExpiryTime := nameInfo.ExpiryTime
 
-	if ctx.BlockTime().Before(nameInfo.ExpiryTime) {
-		// If renewal is done before the expiry time, renew for 1 year after the expiry time
-		nameInfo.ExpiryTime = nameInfo.ExpiryTime.Add(k.NameInfoDuration(ctx))
-	} else {
-		return types.ErrExpiredNameRenewal
-	}
-
-	if nameInfo.ExpiryTime.After(ctx.BlockTime().Add(k.NameInfoMaxDuration(ctx))) {
-		return types.ErrMaximumDurationExceeded
-	}
+	// renew after the expiry time, including names in the grace and redemption periods
+	nameInfo.ExpiryTime = nameInfo.ExpiryTime.Add(time.Duration(periods) * k.NameInfoDuration(ctx))
 
 	k.RemoveFromExpiredNameInfoQueue(ctx, name, oldExpiryTime)
 	k.InsertExpiredNameInfoQueue(ctx, name, nameInfo.ExpiryTime)
 
 	k.SetNameInfo(ctx, name, nameInfo)
+	k.AppendNameHistory(ctx, nameInfo, types.HistoryEventRenew, nil, nil)
+	k.RenewSubnames(ctx, name, nameInfo.ExpiryTime)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -139,14 +176,29 @@ func (k Keeper) HandleDeleteName(ctx sdk.Context, name string, owner sdk.AccAddr
 		return types.ErrNameNotRegistered
 	}
 
+	if nameInfo.IsSubname() {
+		return k.handleDeleteSubname(ctx, nameInfo, owner)
+	}
+
 	if ! owner.Equals(nameInfo.Owner) {
 		return types.ErrNotOwner
 	}
 
+	if k.HasAuction(ctx, name) {
+		return types.ErrNameInAuction
+	}
+
 	k.RemoveFromExpiredNameInfoQueue(ctx, nameInfo.Name, nameInfo.ExpiryTime)
 
 	k.DeleteNameInfo(ctx, name)
 	k.DeleteNameInfoStatusMap(ctx, owner, name)
+	k.AppendNameHistory(ctx, nameInfo, types.HistoryEventDelete, nil, nil)
+	k.ClearPendingTransfer(ctx, name)
+	k.DeleteSubnames(ctx, name)
+	k.ClearPrimaryName(ctx, owner, name)
+	k.RemoveAllTextRecords(ctx, name)
+	k.RemoveAllNameAddresses(ctx, name)
+	k.RemoveAllOperators(ctx, name)
 
 	// if last HRA remove all associated addresses
 	if ! k.OwnsAnyName(ctx, owner) {
@@ -163,44 +215,97 @@ func (k Keeper) HandleDeleteName(ctx sdk.Context, name string, owner sdk.AccAddr
 }
 
 func (k Keeper) HandleTransferName(ctx sdk.Context, name string, owner sdk.AccAddress, newOwner sdk.AccAddress) error {
+	nameInfo, err := k.validateTransfer(ctx, name, owner, newOwner)
+	if err != nil {
+		return err
+	}
+
+	return k.transferName(ctx, nameInfo, newOwner)
+}
+
+// validateTransfer checks that the owner can hand the name over right now, either instantly or by starting a
+// delayed transfer.
+func (k Keeper) validateTransfer(ctx sdk.Context, name string, owner sdk.AccAddress, newOwner sdk.AccAddress) (types.NameInfo, error) {
 	nameInfo, found := k.GetNameInfo(ctx, name)
 	if ! found {
-		return types.ErrNameNotRegistered
+		return types.NameInfo{}, types.ErrNameNotRegistered
 	}
 
 	if ! owner.Equals(nameInfo.Owner) {
-		return types.ErrNotOwner
+		return types.NameInfo{}, types.ErrNotOwner
 	}
 
 	if nameInfo.Owner.Equals(newOwner) {
-		return types.ErrAlreadyOwned
+		return types.NameInfo{}, types.ErrAlreadyOwned
+	}
+
+	if k.IsNameExpired(ctx, nameInfo) {
+		return types.NameInfo{}, types.ErrNameExpired
+	}
+
+	if k.HasAuction(ctx, name) {
+		return types.NameInfo{}, types.ErrNameInAuction
 	}
 
+	if k.HasPendingTransfer(ctx, name) {
+		return types.NameInfo{}, types.ErrTransferPending
+	}
+
+	return nameInfo, nil
+}
+
+func (k Keeper) transferName(ctx sdk.Context, nameInfo types.NameInfo, newOwner sdk.AccAddress) error {
 	account := k.AccountKeeper.GetAccount(ctx, newOwner)
 	if account == nil {
 		account = k.AccountKeeper.NewAccountWithAddress(ctx, newOwner)
 		k.AccountKeeper.SetAccount(ctx, account)
 	}
 
+	if nameInfo.IsSubname() {
+		k.changeSubnameOwner(ctx, nameInfo, newOwner)
+
+		return nil
+	}
+
+	return k.ChangeNameOwner(ctx, nameInfo, newOwner, nil)
+}
+
+// ChangeNameOwner moves the name to the new owner and resets its price. The change is recorded as a sale when a
+// price is paid. Credits, blockchain addresses and name hooks of both owners are kept in sync.
+func (k Keeper) ChangeNameOwner(ctx sdk.Context, nameInfo types.NameInfo, newOwner sdk.AccAddress, price sdk.Coins) error {
+	oldOwner := nameInfo.Owner
+
 	if ! k.OwnsAnyName(ctx, newOwner) {
 		k.SetCredits(ctx, newOwner, k.AddressCredits(ctx))
 		k.AfterFirstNameCreated(ctx, newOwner)
 	}
 
 	// update the status mapping
-	k.DeleteNameInfoStatusMap(ctx, nameInfo.Owner, name)
-	k.SetNameInfoStatusMap(ctx, newOwner, name)
+	k.DeleteNameInfoStatusMap(ctx, oldOwner, nameInfo.Name)
+	k.SetNameInfoStatusMap(ctx, newOwner, nameInfo.Name)
+
+	// transfers, purchases and auction settlements all end up here
+	k.ClearPrimaryName(ctx, oldOwner, nameInfo.Name)
+	k.RemoveAllTextRecords(ctx, nameInfo.Name)
+	k.RemoveAllNameAddresses(ctx, nameInfo.Name)
+	k.RemoveAllOperators(ctx, nameInfo.Name)
 
 	// update the owner and reset the price
 	nameInfo.Owner = newOwner
 	nameInfo.Price = sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, 0))
 
-	k.SetNameInfo(ctx, name, nameInfo)
+	k.SetNameInfo(ctx, nameInfo.Name, nameInfo)
 
-	if ! k.OwnsAnyName(ctx, owner) {
-		k.RemoveAllAddresses(ctx, owner)
-		k.SetCredits(ctx, owner, sdk.ZeroInt())
-		err := k.AfterLastNameRemoved(ctx, owner)
+	if price.IsZero() {
+		k.AppendNameHistory(ctx, nameInfo, types.HistoryEventTransfer, oldOwner, nil)
+	} else {
+		k.AppendNameHistory(ctx, nameInfo, types.HistoryEventSale, oldOwner, price)
+	}
+
+	if ! k.OwnsAnyName(ctx, oldOwner) {
+		k.RemoveAllAddresses(ctx, oldOwner)
+		k.SetCredits(ctx, oldOwner, sdk.ZeroInt())
+		err := k.AfterLastNameRemoved(ctx, oldOwner)
 		if err != nil {
 			return err
 		}
@@ -213,3 +318,45 @@ func (k Keeper) GetNamesIterator(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
 	return sdk.KVStorePrefixIterator(store, types.NameInfoByNameKeyPrefix)
 }
+
+// Returns an iterator through all names starting with the prefix, an empty prefix matches every name
+func (k Keeper) GetNamesByPrefixIterator(ctx sdk.Context, prefix string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetNameInfoByNameKey(prefix))
+}
+
+// Returns an iterator through the names for sale starting with the prefix, an empty prefix matches every name for sale
+func (k Keeper) GetNamesForSaleIterator(ctx sdk.Context, prefix string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetNameForSaleKey(prefix))
+}
+
+// IndexNamesForSale builds the index of names for sale from the stored names, it is needed once for names priced
+// before the index existed.
+func (k Keeper) IndexNamesForSale(ctx sdk.Context) {
+	var nameInfos []types.NameInfo
+	k.IterateNameInfos(ctx, "", func(nameInfo types.NameInfo) (stop bool) {
+		if ! nameInfo.Price.IsZero() {
+			nameInfos = append(nameInfos, nameInfo)
+		}
+		return false
+	})
+
+	for _, nameInfo := range nameInfos {
+		k.SetNameInfo(ctx, nameInfo.Name, nameInfo)
+	}
+}
+
+func (k Keeper) IterateNameInfos(ctx sdk.Context, prefix string, cb func(nameInfo types.NameInfo) (stop bool)) {
+	iterator := k.GetNamesByPrefixIterator(ctx, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var nameInfo types.NameInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &nameInfo)
+
+		if cb(nameInfo) {
+			break
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func TestMultiPeriodRegistrationAndRenewal(t *testing.T) {
+	input := createTestInput(t)
+	owner := testAddrs[0]
+	duration := input.keeper.NameInfoDuration(input.ctx)
+
+	// the default maximum duration allows three periods
+	fee, err := input.keeper.GetRegistrationFee(input.ctx, "prepaid", 4)
+	require.NoError(t, err)
+	fund(t, input, owner, fee)
+
+	require.True(t, types.ErrMaximumDurationExceeded.Is(input.keeper.HandleRegisterName(input.ctx, "prepaid", owner, 4)))
+	require.Equal(t, types.ErrInvalidPeriods, input.keeper.HandleRegisterName(input.ctx, "prepaid", owner, 0))
+
+	single, err := input.keeper.GetRegistrationFee(input.ctx, "prepaid", 1)
+	require.NoError(t, err)
+
+	balance := input.bankKeeper.GetCoins(input.ctx, owner)
+	require.NoError(t, input.keeper.HandleRegisterName(input.ctx, "prepaid", owner, 2))
+	require.Equal(t, balance.Sub(multiplyFee(single, 2)), input.bankKeeper.GetCoins(input.ctx, owner))
+
+	nameInfo, _ := input.keeper.GetNameInfo(input.ctx, "prepaid")
+	require.Equal(t, input.ctx.BlockTime().Add(2 * duration), nameInfo.ExpiryTime)
+	require.Equal(t, uint64(1), input.keeper.GetAvailablePeriods(input.ctx, nameInfo.ExpiryTime))
+
+	fund(t, input, owner, multiplyFee(input.keeper.NameInfoRenewalFee(input.ctx), 2))
+	require.True(t, types.ErrMaximumDurationExceeded.Is(input.keeper.HandleRenewName(input.ctx, "prepaid", owner, 2)))
+	require.NoError(t, input.keeper.HandleRenewName(input.ctx, "prepaid", owner, 1))
+
+	// a year later another period can be bought
+	later := input.ctx.WithBlockTime(input.ctx.BlockTime().Add(duration + time.Hour))
+	require.NoError(t, input.keeper.HandleRenewName(later, "prepaid", owner, 1))
+
+	nameInfo, _ = input.keeper.GetNameInfo(later, "prepaid")
+	require.Equal(t, input.ctx.BlockTime().Add(4 * duration), nameInfo.ExpiryTime)
+}
+
+func TestPeriodsAreBoundedBeforeFeesAreMultiplied(t *testing.T) {
+	input := createTestInput(t)
+	owner := testAddrs[0]
+
+	// messages without periods register or renew for a single period
+	msg := types.NewMsgRegisterName("bounded", owner, 0)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, uint64(1), msg.GetPeriods())
+	require.Equal(t, uint64(1), types.NewMsgRenewName("bounded", owner, 0).GetPeriods())
+
+	require.True(t, types.ErrInvalidPeriods.Is(types.NewMsgRegisterName("bounded", owner, types.MaxPeriods + 1).ValidateBasic()))
+	require.True(t, types.ErrInvalidPeriods.Is(types.NewMsgRenewName("bounded", owner, math.MaxUint64).ValidateBasic()))
+
+	// the fee of an unbounded number of periods is rejected instead of overflowing
+	_, err := input.keeper.GetRegistrationFee(input.ctx, "bounded", math.MaxUint64)
+	require.True(t, types.ErrInvalidPeriods.Is(err), err)
+
+	registerName(t, input, "bounded", owner)
+
+	_, err = input.keeper.GetRenewalFee(input.ctx, "bounded", math.MaxUint64)
+	require.True(t, types.ErrInvalidPeriods.Is(err), err)
+	require.True(t, types.ErrInvalidPeriods.Is(input.keeper.HandleRenewName(input.ctx, "bounded", owner, math.MaxUint64)))
+}
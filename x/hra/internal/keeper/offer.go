@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+	"time"
+)
+
+func (k Keeper) HandlePlaceOffer(ctx sdk.Context, name string, bidder sdk.AccAddress, amount sdk.Coins, duration time.Duration) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if nameInfo.IsSubname() {
+		return types.ErrSubnameNotAllowed
+	}
+
+	if k.IsNameExpired(ctx, nameInfo) {
+		return types.ErrNameExpired
+	}
+
+	if nameInfo.Owner.Equals(bidder) {
+		return types.ErrAlreadyOwned
+	}
+
+	if k.HasOffer(ctx, name, bidder) {
+		return types.ErrOfferExists
+	}
+
+	if duration <= 0 || duration > k.OfferMaxDuration(ctx) {
+		return sdkerrors.Wrapf(types.ErrInvalidOfferDuration, "Offers can last at most %s.", k.OfferMaxDuration(ctx))
+	}
+
+	err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.OfferEscrowModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	offer := types.NewOffer(name, bidder, amount, ctx.BlockTime().Add(duration))
+
+	k.SetOffer(ctx, offer)
+
+	return nil
+}
+
+// HandleAcceptOffer pays the escrowed amount to the current owner and hands the name over to the bidder.
+func (k Keeper) HandleAcceptOffer(ctx sdk.Context, name string, owner sdk.AccAddress, bidder sdk.AccAddress) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! owner.Equals(nameInfo.Owner) {
+		return types.ErrNotOwner
+	}
+
+	if k.HasAuction(ctx, name) {
+		return types.ErrNameInAuction
+	}
+
+	if k.HasPendingTransfer(ctx, name) {
+		return types.ErrTransferPending
+	}
+
+	if k.IsNameExpired(ctx, nameInfo) {
+		return types.ErrNameExpired
+	}
+
+	offer, found := k.GetOffer(ctx, name, bidder)
+	if ! found {
+		return types.ErrOfferNotFound
+	}
+
+	if ! ctx.BlockTime().Before(offer.ExpiryTime) {
+		return types.ErrOfferExpired
+	}
+
+	if offer.Bidder.Equals(owner) {
+		return types.ErrAlreadyOwned
+	}
+
+	k.DeleteOffer(ctx, offer)
+
+	err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.OfferEscrowModuleName, owner, offer.Amount)
+	if err != nil {
+		return err
+	}
+
+	return k.ChangeNameOwner(ctx, nameInfo, bidder, offer.Amount)
+}
+
+func (k Keeper) HandleWithdrawOffer(ctx sdk.Context, name string, bidder sdk.AccAddress) error {
+	offer, found := k.GetOffer(ctx, name, bidder)
+	if ! found {
+		return types.ErrOfferNotFound
+	}
+
+	k.DeleteOffer(ctx, offer)
+
+	return k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.OfferEscrowModuleName, bidder, offer.Amount)
+}
+
+// ExpireOffer refunds an offer that was neither accepted nor withdrawn before its expiry time.
+func (k Keeper) ExpireOffer(ctx sdk.Context, offer types.Offer) error {
+	k.DeleteOffer(ctx, offer)
+
+	err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.OfferEscrowModuleName, offer.Bidder, offer.Amount)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExpireOffer,
+			sdk.NewAttribute(types.AttributeKeyName, offer.Name),
+			sdk.NewAttribute(types.AttributeKeyBidder, offer.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, offer.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) GetOffer(ctx sdk.Context, name string, bidder sdk.AccAddress) (types.Offer, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetOfferKey(name, bidder))
+	if bz == nil {
+		return types.Offer{}, false
+	}
+
+	var offer types.Offer
+	k.cdc.MustUnmarshalBinaryBare(bz, &offer)
+
+	return offer, true
+}
+
+// SetOffer stores the offer together with its bidder index and expiry queue entries.
+func (k Keeper) SetOffer(ctx sdk.Context, offer types.Offer) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetOfferKey(offer.Name, offer.Bidder), k.cdc.MustMarshalBinaryBare(offer))
+	store.Set(types.GetBidderOfferKey(offer.Bidder, offer.Name), types.StatusPresent)
+	store.Set(types.OfferQueueKey(offer.Name, offer.Bidder, offer.ExpiryTime), []byte(offer.Name))
+}
+
+func (k Keeper) DeleteOffer(ctx sdk.Context, offer types.Offer) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetOfferKey(offer.Name, offer.Bidder))
+	store.Delete(types.GetBidderOfferKey(offer.Bidder, offer.Name))
+	k.RemoveFromOfferQueue(ctx, offer)
+}
+
+func (k Keeper) RemoveFromOfferQueue(ctx sdk.Context, offer types.Offer) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.OfferQueueKey(offer.Name, offer.Bidder, offer.ExpiryTime))
+}
+
+func (k Keeper) HasOffer(ctx sdk.Context, name string, bidder sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetOfferKey(name, bidder))
+}
+
+func (k Keeper) IterateOffers(ctx sdk.Context, name string, cb func(offer types.Offer) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetOfferIteratorKey(name))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var offer types.Offer
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &offer)
+
+		if cb(offer) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateBidderOffers(ctx sdk.Context, bidder sdk.AccAddress, cb func(offer types.Offer) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetBidderOfferIteratorKey(bidder))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		name := types.SplitBidderOfferKey(bidder, iterator.Key())
+
+		offer, found := k.GetOffer(ctx, name, bidder)
+		if ! found {
+			continue
+		}
+
+		if cb(offer) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAllOffers(ctx sdk.Context, cb func(offer types.Offer) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.OfferKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var offer types.Offer
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &offer)
+
+		if cb(offer) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateOfferQueue(ctx sdk.Context, endTime time.Time, cb func(name string, bidder sdk.AccAddress) (stop bool)) {
+	iterator := k.OfferQueueIterator(ctx, endTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		name, bidder, _ := types.SplitOfferQueueKey(iterator.Key())
+
+		if cb(name, bidder) {
+			break
+		}
+	}
+}
+
+func (k Keeper) OfferQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.OfferQueueKeyPrefix, sdk.PrefixEndBytes(types.OfferByTimeKey(endTime)))
+}
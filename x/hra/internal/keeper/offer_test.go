@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func TestAcceptOfferPaysOwnerAndTransfersName(t *testing.T) {
+	input := createTestInput(t)
+	owner, alice, bob := testAddrs[0], testAddrs[1], testAddrs[2]
+
+	registerName(t, input, "offered", owner)
+
+	fund(t, input, alice, pin(1000))
+	fund(t, input, bob, pin(1000))
+
+	require.Equal(t, types.ErrAlreadyOwned, input.keeper.HandlePlaceOffer(input.ctx, "offered", owner, pin(100), time.Hour))
+	require.Error(t, input.keeper.HandlePlaceOffer(input.ctx, "offered", alice, pin(100), input.keeper.OfferMaxDuration(input.ctx) + time.Second))
+
+	require.NoError(t, input.keeper.HandlePlaceOffer(input.ctx, "offered", alice, pin(300), time.Hour))
+	require.Equal(t, types.ErrOfferExists, input.keeper.HandlePlaceOffer(input.ctx, "offered", alice, pin(400), time.Hour))
+	require.NoError(t, input.keeper.HandlePlaceOffer(input.ctx, "offered", bob, pin(200), time.Hour))
+
+	require.Equal(t, pin(500), input.supplyKeeper.GetModuleAccount(input.ctx, types.OfferEscrowModuleName).GetCoins())
+
+	require.Equal(t, types.ErrNotOwner, input.keeper.HandleAcceptOffer(input.ctx, "offered", bob, alice))
+
+	ownerBalance := input.bankKeeper.GetCoins(input.ctx, owner)
+	require.NoError(t, input.keeper.HandleAcceptOffer(input.ctx, "offered", owner, alice))
+
+	nameInfo, _ := input.keeper.GetNameInfo(input.ctx, "offered")
+	require.Equal(t, alice, nameInfo.Owner)
+	require.Equal(t, ownerBalance.Add(pin(300)...), input.bankKeeper.GetCoins(input.ctx, owner))
+	require.False(t, input.keeper.HasOffer(input.ctx, "offered", alice))
+
+	// the other offers stay open for the new owner
+	require.True(t, input.keeper.HasOffer(input.ctx, "offered", bob))
+	require.Equal(t, pin(200), input.supplyKeeper.GetModuleAccount(input.ctx, types.OfferEscrowModuleName).GetCoins())
+}
+
+func TestWithdrawnAndExpiredOffersAreRefunded(t *testing.T) {
+	input := createTestInput(t)
+	owner, alice, bob := testAddrs[0], testAddrs[1], testAddrs[2]
+
+	registerName(t, input, "refunded", owner)
+
+	fund(t, input, alice, pin(1000))
+	fund(t, input, bob, pin(1000))
+
+	require.NoError(t, input.keeper.HandlePlaceOffer(input.ctx, "refunded", alice, pin(300), time.Hour))
+	require.NoError(t, input.keeper.HandlePlaceOffer(input.ctx, "refunded", bob, pin(200), time.Hour))
+
+	require.NoError(t, input.keeper.HandleWithdrawOffer(input.ctx, "refunded", alice))
+	require.Equal(t, pin(1000), input.bankKeeper.GetCoins(input.ctx, alice))
+	require.Equal(t, types.ErrOfferNotFound, input.keeper.HandleWithdrawOffer(input.ctx, "refunded", alice))
+
+	expired := input.ctx.WithBlockTime(input.ctx.BlockTime().Add(time.Hour))
+	require.Equal(t, types.ErrOfferExpired, input.keeper.HandleAcceptOffer(expired, "refunded", owner, bob))
+
+	offer, found := input.keeper.GetOffer(expired, "refunded", bob)
+	require.True(t, found)
+	require.NoError(t, input.keeper.ExpireOffer(expired, offer))
+
+	require.Equal(t, pin(1000), input.bankKeeper.GetCoins(expired, bob))
+	require.False(t, input.keeper.HasOffer(expired, "refunded", bob))
+	require.True(t, input.supplyKeeper.GetModuleAccount(expired, types.OfferEscrowModuleName).GetCoins().IsZero())
+}
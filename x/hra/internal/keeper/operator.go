@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+	"strings"
+)
+
+// Operators approved by the owner can manage the address records of a name, renew it and set its price. Opening an
+// auction, transferring or deleting the name always requires the owner.
+func (k Keeper) HandleApproveOperator(ctx sdk.Context, name string, owner sdk.AccAddress, operator sdk.AccAddress) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! owner.Equals(nameInfo.Owner) {
+		return types.ErrNotOwner
+	}
+
+	if owner.Equals(operator) {
+		return types.ErrInvalidOperator
+	}
+
+	if k.IsNameExpired(ctx, nameInfo) {
+		return types.ErrNameExpired
+	}
+
+	k.SetOperator(ctx, name, operator)
+
+	return nil
+}
+
+func (k Keeper) HandleRevokeOperator(ctx sdk.Context, name string, owner sdk.AccAddress, operator sdk.AccAddress) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! owner.Equals(nameInfo.Owner) {
+		return types.ErrNotOwner
+	}
+
+	if ! k.IsOperator(ctx, name, operator) {
+		return types.ErrOperatorNotFound
+	}
+
+	k.RemoveOperator(ctx, name, operator)
+
+	return nil
+}
+
+func (k Keeper) HandleOperatorRegisterAddress(ctx sdk.Context, name string, operator sdk.AccAddress, blockchainId string, index string, blockchainAddress string) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if nameInfo.IsSubname() {
+		return k.HandleRegisterSubnameAddress(ctx, name, operator, blockchainId, index, blockchainAddress)
+	}
+
+	if ! k.IsOwnerOrOperator(ctx, nameInfo, operator) {
+		return types.ErrNotOwner
+	}
+
+	if k.IsNameExpired(ctx, nameInfo) {
+		return types.ErrNameExpired
+	}
+
+	// the records of the owner's account are shared by all of their names, operators only manage the records of the
+	// name itself, which take precedence over the owner's records when the name is resolved
+	return k.registerNameAddress(ctx, name, operator, blockchainId, index, blockchainAddress)
+}
+
+func (k Keeper) HandleOperatorRemoveAddress(ctx sdk.Context, name string, operator sdk.AccAddress, blockchainId string, index string) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if nameInfo.IsSubname() {
+		return k.HandleRemoveSubnameAddress(ctx, name, operator, blockchainId, index)
+	}
+
+	if ! k.IsOwnerOrOperator(ctx, nameInfo, operator) {
+		return types.ErrNotOwner
+	}
+
+	k.RemoveNameAddress(ctx, name, blockchainId, index)
+
+	return nil
+}
+
+func (k Keeper) IsOwnerOrOperator(ctx sdk.Context, nameInfo types.NameInfo, address sdk.AccAddress) bool {
+	return address.Equals(nameInfo.Owner) || k.IsOperator(ctx, nameInfo.Name, address)
+}
+
+func (k Keeper) SetOperator(ctx sdk.Context, name string, operator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetOperatorKey(name, operator), types.StatusPresent)
+}
+
+func (k Keeper) RemoveOperator(ctx sdk.Context, name string, operator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetOperatorKey(name, operator))
+}
+
+func (k Keeper) IsOperator(ctx sdk.Context, name string, operator sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetOperatorKey(name, operator))
+}
+
+func (k Keeper) GetOperatorIterator(ctx sdk.Context, name string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetOperatorIteratorKey(name))
+}
+
+// RemoveAllOperators is called whenever the name is deleted or changes hands.
+func (k Keeper) RemoveAllOperators(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := k.GetOperatorIterator(ctx, name)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
+
+func (k Keeper) IterateOperators(ctx sdk.Context, name string, cb func(operator sdk.AccAddress) (stop bool)) {
+	iterator := k.GetOperatorIterator(ctx, name)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.SplitOperatorKey(name, iterator.Key())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAllOperators(ctx sdk.Context, cb func(operatorInfo types.OperatorInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.OperatorKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		name := strings.SplitN(string(iterator.Key()[1:]), types.Separator, 2)[0]
+
+		if cb(types.NewOperatorInfo(name, types.SplitOperatorKey(name, iterator.Key()))) {
+			break
+		}
+	}
+}
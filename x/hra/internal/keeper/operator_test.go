@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func TestOperatorPermissions(t *testing.T) {
+	input := createTestInput(t)
+	owner, operator, other := testAddrs[0], testAddrs[1], testAddrs[2]
+	ethAddress := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+	registerName(t, input, "delegated", owner)
+	input.keeper.SetRegisteredBlockchainId(input.ctx, "eth")
+
+	require.Equal(t, types.ErrNotOwner, input.keeper.HandleApproveOperator(input.ctx, "delegated", operator, operator))
+	require.Equal(t, types.ErrInvalidOperator, input.keeper.HandleApproveOperator(input.ctx, "delegated", owner, owner))
+	require.NoError(t, input.keeper.HandleApproveOperator(input.ctx, "delegated", owner, operator))
+
+	fund(t, input, operator, pin(1000000000000))
+	fund(t, input, other, pin(1000000000000))
+
+	// operators manage the address records of the name and can renew it and set its price
+	require.Equal(t, types.ErrNotOwner, input.keeper.HandleOperatorRegisterAddress(input.ctx, "delegated", other, "eth", "0", ethAddress))
+	require.NoError(t, input.keeper.HandleOperatorRegisterAddress(input.ctx, "delegated", operator, "eth", "0", ethAddress))
+
+	address, err := input.keeper.GetNameAddress(input.ctx, "delegated", "eth", "0")
+	require.NoError(t, err)
+	require.Equal(t, ethAddress, address)
+
+	require.NoError(t, input.keeper.HandleRenewName(input.ctx, "delegated", operator, 1))
+	require.Equal(t, types.ErrNotOwner, input.keeper.HandleSetPrice(input.ctx, "delegated", other, pin(1)))
+	require.NoError(t, input.keeper.HandleSetPrice(input.ctx, "delegated", operator, pin(1)))
+
+	price, err := input.keeper.GetPrice(input.ctx, "delegated")
+	require.NoError(t, err)
+	require.Equal(t, pin(1), price)
+
+	// everything else still requires the owner
+	require.Equal(t, types.ErrNotOwner, input.keeper.HandleSetPrimaryName(input.ctx, "delegated", operator))
+	require.Error(t, input.keeper.HandleTransferName(input.ctx, "delegated", operator, operator))
+	require.Error(t, input.keeper.HandleDeleteName(input.ctx, "delegated", operator))
+
+	require.NoError(t, input.keeper.HandleOperatorRemoveAddress(input.ctx, "delegated", operator, "eth", "0"))
+	_, err = input.keeper.GetNameAddress(input.ctx, "delegated", "eth", "0")
+	require.Error(t, err)
+
+	require.NoError(t, input.keeper.HandleRevokeOperator(input.ctx, "delegated", owner, operator))
+	require.Equal(t, types.ErrOperatorNotFound, input.keeper.HandleRevokeOperator(input.ctx, "delegated", owner, operator))
+	require.Equal(t, types.ErrNotOwner, input.keeper.HandleOperatorRegisterAddress(input.ctx, "delegated", operator, "eth", "0", ethAddress))
+}
+
+func TestOperatorsRemovedWhenOwnerChanges(t *testing.T) {
+	input := createTestInput(t)
+	owner, operator, buyer := testAddrs[0], testAddrs[1], testAddrs[2]
+
+	registerName(t, input, "resold", owner)
+	require.NoError(t, input.keeper.HandleApproveOperator(input.ctx, "resold", owner, operator))
+
+	require.NoError(t, input.keeper.HandleSetPrice(input.ctx, "resold", owner, pin(500)))
+	fund(t, input, buyer, pin(500))
+	require.NoError(t, input.keeper.HandleBuyName(input.ctx, "resold", buyer))
+
+	require.False(t, input.keeper.IsOperator(input.ctx, "resold", operator))
+}
@@ -19,6 +19,18 @@ func (k Keeper) NameInfoMaxDuration(ctx sdk.Context) (res time.Duration) {
 	return
 }
 
+// NameInfoGracePeriod
+func (k Keeper) NameInfoGracePeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyNameInfoGracePeriod, &res)
+	return
+}
+
+// NameInfoRedemptionPeriod
+func (k Keeper) NameInfoRedemptionPeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyNameInfoRedemptionPeriod, &res)
+	return
+}
+
 // NameInfoRegistrationFee
 func (k Keeper) NameInfoRegistrationFee(ctx sdk.Context) (res sdk.Coins) {
 	k.paramspace.Get(ctx, types.KeyNameInfoRegistrationFee, &res)
@@ -31,6 +43,12 @@ func (k Keeper) NameInfoRenewalFee(ctx sdk.Context) (res sdk.Coins) {
 	return
 }
 
+// NameInfoRedemptionFee
+func (k Keeper) NameInfoRedemptionFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramspace.Get(ctx, types.KeyNameInfoRedemptionFee, &res)
+	return
+}
+
 // AddressCredits
 func (k Keeper) AddressCredits(ctx sdk.Context) (res sdk.Int) {
 	k.paramspace.Get(ctx, types.KeyAddressCredits, &res)
@@ -43,6 +61,68 @@ func (k Keeper) AddressRegistrationFee(ctx sdk.Context) (res sdk.Coins) {
 	return
 }
 
+// SubnameRegistrationFee
+func (k Keeper) SubnameRegistrationFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramspace.Get(ctx, types.KeySubnameRegistrationFee, &res)
+	return
+}
+
+// AuctionRevealDuration
+func (k Keeper) AuctionRevealDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyAuctionRevealDuration, &res)
+	return
+}
+
+// OfferMaxDuration
+func (k Keeper) OfferMaxDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyOfferMaxDuration, &res)
+	return
+}
+
+// NameHistoryRetention
+func (k Keeper) NameHistoryRetention(ctx sdk.Context) (res uint64) {
+	k.paramspace.Get(ctx, types.KeyNameHistoryRetention, &res)
+	return
+}
+
+func (k Keeper) SetNameHistoryRetention(ctx sdk.Context, retention uint64) {
+	k.paramspace.Set(ctx, types.KeyNameHistoryRetention, &retention)
+}
+
+// TransferDelay
+func (k Keeper) TransferDelay(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyTransferDelay, &res)
+	return
+}
+
+// TextRecordMaxCount
+func (k Keeper) TextRecordMaxCount(ctx sdk.Context) (res uint64) {
+	k.paramspace.Get(ctx, types.KeyTextRecordMaxCount, &res)
+	return
+}
+
+// TextRecordMaxValueLength
+func (k Keeper) TextRecordMaxValueLength(ctx sdk.Context) (res uint64) {
+	k.paramspace.Get(ctx, types.KeyTextRecordMaxValueLength, &res)
+	return
+}
+
+// TextRecordFee
+func (k Keeper) TextRecordFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramspace.Get(ctx, types.KeyTextRecordFee, &res)
+	return
+}
+
+// NameInfoPricingSchedule
+func (k Keeper) NameInfoPricingSchedule(ctx sdk.Context) (res types.PricingSchedule) {
+	k.paramspace.Get(ctx, types.KeyNameInfoPricingSchedule, &res)
+	return
+}
+
+func (k Keeper) SetNameInfoPricingSchedule(ctx sdk.Context, pricingSchedule types.PricingSchedule) {
+	k.paramspace.Set(ctx, types.KeyNameInfoPricingSchedule, &pricingSchedule)
+}
+
 // GetParams returns the total set of hra parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
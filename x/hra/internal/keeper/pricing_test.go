@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func TestRegistrationFeeFollowsPricingTiers(t *testing.T) {
+	input := createTestInput(t)
+
+	flatFee := input.keeper.NameInfoRegistrationFee(input.ctx)
+
+	input.keeper.SetNameInfoPricingSchedule(input.ctx, types.PricingSchedule{
+		types.NewPricingTier(3, pin(1000), sdk.NewDec(2)),
+		types.NewPricingTier(5, pin(500), sdk.ZeroDec()),
+	})
+
+	for _, tc := range []struct {
+		name    string
+		periods uint64
+		fee     sdk.Coins
+	}{
+		{"abc", 1, pin(1000)},
+		{"123", 1, pin(2000)},
+		{"123", 3, pin(6000)},
+		{"abcd", 1, pin(500)},
+		{"12345", 2, pin(1000)},
+		{"abcdef", 1, flatFee},
+		{"123456", 2, multiplyFee(flatFee, 2)},
+	} {
+		fee, err := input.keeper.GetRegistrationFee(input.ctx, tc.name, tc.periods)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.fee, fee, tc.name)
+	}
+
+	_, err := input.keeper.GetRegistrationFee(input.ctx, "abc", 0)
+	require.Equal(t, types.ErrInvalidPeriods, err)
+}
+
+func TestRegistrationChargesTheQuotedFee(t *testing.T) {
+	input := createTestInput(t)
+	owner := testAddrs[0]
+
+	querier := NewQuerier(input.keeper)
+
+	res, err := querier(input.ctx, []string{QueryPriceQuote, "abc", "2"}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var quote types.QueryResPriceQuote
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(res, &quote))
+	require.Equal(t, uint64(2), quote.Periods)
+	require.Equal(t, multiplyFee(types.DefaultPricingSchedule()[0].Fee, 2), quote.Fee)
+
+	// one coin short of the quote is not enough
+	fund(t, input, owner, quote.Fee.Sub(pin(1)))
+	require.Error(t, input.keeper.HandleRegisterName(input.ctx, "abc", owner, 2))
+
+	fund(t, input, owner, pin(1))
+	require.NoError(t, input.keeper.HandleRegisterName(input.ctx, "abc", owner, 2))
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, owner).IsZero())
+
+	_, err = querier(input.ctx, []string{QueryPriceQuote, "a-"}, abci.RequestQuery{})
+	require.Error(t, err)
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func (k Keeper) HandleSetPrimaryName(ctx sdk.Context, name string, owner sdk.AccAddress) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! owner.Equals(nameInfo.Owner) {
+		return types.ErrNotOwner
+	}
+
+	if k.IsNameExpired(ctx, nameInfo) {
+		return types.ErrNameExpired
+	}
+
+	k.SetPrimaryName(ctx, owner, name)
+
+	return nil
+}
+
+// ClearPrimaryName removes the primary name of the address if it points to the given name.
+// It is called whenever the address stops owning the name.
+func (k Keeper) ClearPrimaryName(ctx sdk.Context, address sdk.AccAddress, name string) {
+	primaryName, found := k.GetPrimaryName(ctx, address)
+	if ! found || primaryName != name {
+		return
+	}
+
+	k.DeletePrimaryName(ctx, address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClearPrimaryName,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyOwner, address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+}
+
+func (k Keeper) GetPrimaryName(ctx sdk.Context, address sdk.AccAddress) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	name := store.Get(types.GetPrimaryNameKey(address))
+	if name == nil {
+		return "", false
+	}
+
+	return string(name), true
+}
+
+func (k Keeper) SetPrimaryName(ctx sdk.Context, address sdk.AccAddress, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetPrimaryNameKey(address), []byte(name))
+}
+
+func (k Keeper) DeletePrimaryName(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetPrimaryNameKey(address))
+}
+
+func (k Keeper) IteratePrimaryNames(ctx sdk.Context, cb func(address sdk.AccAddress, name string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrimaryNameKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := sdk.AccAddress(iterator.Key()[1:])
+
+		if cb(address, string(iterator.Value())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func TestPrimaryNameClearedWhenOwnerChanges(t *testing.T) {
+	input := createTestInput(t)
+	owner, buyer := testAddrs[0], testAddrs[1]
+
+	registerName(t, input, "primary", owner)
+
+	require.Equal(t, types.ErrNotOwner, input.keeper.HandleSetPrimaryName(input.ctx, "primary", buyer))
+	require.Equal(t, types.ErrNameNotRegistered, input.keeper.HandleSetPrimaryName(input.ctx, "missing", owner))
+	require.NoError(t, input.keeper.HandleSetPrimaryName(input.ctx, "primary", owner))
+
+	name, found := input.keeper.GetPrimaryName(input.ctx, owner)
+	require.True(t, found)
+	require.Equal(t, "primary", name)
+
+	require.NoError(t, input.keeper.HandleSetPrice(input.ctx, "primary", owner, pin(500)))
+	fund(t, input, buyer, pin(500))
+	require.NoError(t, input.keeper.HandleBuyName(input.ctx, "primary", buyer))
+
+	_, found = input.keeper.GetPrimaryName(input.ctx, owner)
+	require.False(t, found)
+	_, found = input.keeper.GetPrimaryName(input.ctx, buyer)
+	require.False(t, found)
+}
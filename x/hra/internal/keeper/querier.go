@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"github.com/anathatech/project-anatha/x/hra/internal/types"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"strconv"
 )
 
 const (
@@ -19,6 +21,26 @@ const (
 	QueryBlockchainAddresses = "blockchain-addresses"
 	QueryParameters = "parameters"
 	QueryModule = "module"
+	QueryAuction = "auction"
+	QueryAuctions = "auctions"
+	QuerySealedBids = "sealed-bids"
+	QuerySubnames = "subnames"
+	QueryReverse = "reverse"
+	QueryTextRecord = "text-record"
+	QueryTextRecords = "text-records"
+	QueryOperators = "operators"
+	QueryPriceQuote = "price-quote"
+	QueryReservedNames = "reserved-names"
+	QueryOffers = "offers"
+	QueryBidderOffers = "bidder-offers"
+	QueryAllNames = "all-names"
+	QueryNamesByPrefix = "names-by-prefix"
+	QueryNamesForSale = "names-for-sale"
+	QueryExpiringNames = "expiring-names"
+	QueryNameHistory = "name-history"
+	QueryPendingTransfer = "pending-transfer"
+	QueryPendingTransfers = "pending-transfers"
+	QueryFailedQueueItems = "failed-queue-items"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -42,6 +64,46 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryParams(ctx, k)
 		case QueryModule:
 			return queryModuleAccount(ctx, path[1:], req, k)
+		case QueryAuction:
+			return queryAuction(ctx, path[1:], req, k)
+		case QueryAuctions:
+			return queryAuctions(ctx, path[1:], req, k)
+		case QuerySealedBids:
+			return querySealedBids(ctx, path[1:], req, k)
+		case QuerySubnames:
+			return querySubnames(ctx, path[1:], req, k)
+		case QueryReverse:
+			return queryReverse(ctx, path[1:], req, k)
+		case QueryTextRecord:
+			return queryTextRecord(ctx, path[1:], req, k)
+		case QueryTextRecords:
+			return queryTextRecords(ctx, path[1:], req, k)
+		case QueryOperators:
+			return queryOperators(ctx, path[1:], req, k)
+		case QueryPriceQuote:
+			return queryPriceQuote(ctx, path[1:], req, k)
+		case QueryReservedNames:
+			return queryReservedNames(ctx, path[1:], req, k)
+		case QueryOffers:
+			return queryOffers(ctx, path[1:], req, k)
+		case QueryBidderOffers:
+			return queryBidderOffers(ctx, path[1:], req, k)
+		case QueryAllNames:
+			return queryAllNames(ctx, req, k)
+		case QueryNamesByPrefix:
+			return queryNamesByPrefix(ctx, req, k)
+		case QueryNamesForSale:
+			return queryNamesForSale(ctx, req, k)
+		case QueryExpiringNames:
+			return queryExpiringNames(ctx, req, k)
+		case QueryNameHistory:
+			return queryNameHistory(ctx, req, k)
+		case QueryPendingTransfer:
+			return queryPendingTransfer(ctx, path[1:], req, k)
+		case QueryPendingTransfers:
+			return queryPendingTransfers(ctx, path[1:], req, k)
+		case QueryFailedQueueItems:
+			return queryFailedQueueItems(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Unknown hra query endpoint: %s", path[0])
 		}
@@ -56,14 +118,34 @@ func queryName(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper)
 
 	resNameInfo := types.QueryResNameInfo{
 		NameInfo: nameInfo,
+		Status: k.GetNameStatus(ctx, nameInfo),
+		GraceEndTime: k.GraceEndTime(ctx, nameInfo),
+		RedemptionEndTime: k.RedemptionEndTime(ctx, nameInfo),
 		Credits:  k.GetCredits(ctx, nameInfo.Owner),
 	}
 
-	k.IterateBlockchainAddressInfos(ctx, nameInfo.Owner, func (info types.BlockchainAddressInfo) (stop bool) {
+	appendAddress := func (info types.BlockchainAddressInfo) (stop bool) {
 		resNameInfo.Addresses = append(resNameInfo.Addresses, info)
 
 		return false
-	})
+	}
+
+	// subnames hold their own blockchain addresses and do not grant address credits
+	if nameInfo.IsSubname() {
+		resNameInfo.Credits = sdk.ZeroInt()
+
+		k.IterateNameAddressInfos(ctx, nameInfo.Name, appendAddress)
+	} else {
+		// records of the name itself take precedence over the records of the owner's account
+		k.IterateBlockchainAddressInfos(ctx, nameInfo.Owner, func (info types.BlockchainAddressInfo) (stop bool) {
+			if _, err := k.GetNameAddress(ctx, nameInfo.Name, info.BlockchainId, info.Index); err == nil {
+				return false
+			}
+
+			return appendAddress(info)
+		})
+		k.IterateNameAddressInfos(ctx, nameInfo.Name, appendAddress)
+	}
 
 	res, marshalErr := codec.MarshalJSONIndent(types.ModuleCdc, resNameInfo)
 
@@ -144,7 +226,18 @@ func queryNameAddress(ctx sdk.Context, path []string, req abci.RequestQuery, k K
 		return nil, types.ErrNameNotRegistered
 	}
 
-	address, err := k.GetAddress(ctx, nameInfo.Owner, path[1], path[2])
+	// names in the grace period still resolve, names in the redemption period do not
+	if k.GetNameStatus(ctx, nameInfo) == types.NameStatusRedemption {
+		return nil, types.ErrNameInRedemption
+	}
+
+	var address string
+	var err error
+
+	address, err = k.GetNameAddress(ctx, nameInfo.Name, path[1], path[2])
+	if err != nil && ! nameInfo.IsSubname() {
+		address, err = k.GetAddress(ctx, nameInfo.Owner, path[1], path[2])
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -225,6 +318,54 @@ func queryModuleAccount(ctx sdk.Context, path []string, req abci.RequestQuery, k
 	return res, nil
 }
 
+func queryAuction(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	auction, found := k.GetAuction(ctx, path[0])
+	if ! found {
+		return nil, types.ErrAuctionNotFound
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, auction)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAuctions(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	auctions := types.QueryResAuctions{}
+
+	k.IterateAuctions(ctx, func(auction types.Auction) (stop bool) {
+		auctions = append(auctions, auction)
+
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, auctions)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func querySealedBids(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	sealedBids := types.QueryResSealedBids{}
+
+	k.IterateSealedBids(ctx, path[0], func(sealedBid types.SealedBid) (stop bool) {
+		sealedBids = append(sealedBids, sealedBid)
+
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, sealedBids)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
 	params := k.GetParams(ctx)
 
@@ -234,4 +375,387 @@ func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
 	}
 
 	return res, nil
-}
\ No newline at end of file
+}
+func querySubnames(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	if ! k.IsNameRegistered(ctx, path[0]) {
+		return nil, types.ErrNameNotRegistered
+	}
+
+	names := types.QueryResNames{}
+
+	k.IterateSubnames(ctx, path[0], func(name string) (stop bool) {
+		names = append(names, name)
+
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, names)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryReverse(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	name, found := k.GetPrimaryName(ctx, address)
+	if ! found {
+		return nil, types.ErrPrimaryNameNotSet
+	}
+
+	// primary names in the redemption period do not resolve
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found || k.GetNameStatus(ctx, nameInfo) == types.NameStatusRedemption {
+		return nil, types.ErrPrimaryNameNotSet
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, name)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryTextRecord(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	nameInfo, found := k.GetNameInfo(ctx, path[0])
+	if ! found {
+		return nil, types.ErrNameNotRegistered
+	}
+
+	if k.GetNameStatus(ctx, nameInfo) == types.NameStatusRedemption {
+		return nil, types.ErrNameInRedemption
+	}
+
+	textRecord, err := k.GetTextRecord(ctx, nameInfo.Name, path[1])
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, textRecord)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryTextRecords(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	nameInfo, found := k.GetNameInfo(ctx, path[0])
+	if ! found {
+		return nil, types.ErrNameNotRegistered
+	}
+
+	if k.GetNameStatus(ctx, nameInfo) == types.NameStatusRedemption {
+		return nil, types.ErrNameInRedemption
+	}
+
+	textRecords := types.QueryResTextRecords{}
+
+	k.IterateTextRecords(ctx, nameInfo.Name, func(textRecord types.TextRecord) (stop bool) {
+		textRecords = append(textRecords, textRecord)
+
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, textRecords)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryOperators(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	nameInfo, found := k.GetNameInfo(ctx, path[0])
+	if ! found {
+		return nil, types.ErrNameNotRegistered
+	}
+
+	operators := types.QueryResOperators{}
+
+	k.IterateOperators(ctx, nameInfo.Name, func(operator sdk.AccAddress) (stop bool) {
+		operators = append(operators, operator)
+
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, operators)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryPriceQuote(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	name := path[0]
+
+	if err := types.ValidateTopLevelName(name); err != nil {
+		return nil, err
+	}
+
+	periods := uint64(1)
+
+	if len(path) > 1 {
+		parsed, err := strconv.ParseUint(path[1], 10, 64)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidPeriods, path[1])
+		}
+
+		periods = parsed
+	}
+
+	fee, err := k.GetRegistrationFee(ctx, name, periods)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryResPriceQuote{Name: name, Periods: periods, Fee: fee})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryReservedNames(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	names := types.QueryResNames{}
+
+	k.IterateReservedNames(ctx, func(name string) (stop bool) {
+		names = append(names, name)
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, names)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryOffers(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	offers := types.QueryResOffers{}
+
+	k.IterateOffers(ctx, path[0], func(offer types.Offer) (stop bool) {
+		offers = append(offers, offer)
+
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, offers)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryBidderOffers(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	bidder, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	offers := types.QueryResOffers{}
+
+	k.IterateBidderOffers(ctx, bidder, func(offer types.Offer) (stop bool) {
+		offers = append(offers, offer)
+
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, offers)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAllNames(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryNamesParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return queryNamesPage(ctx, k, k.GetNamesByPrefixIterator(ctx, ""), types.SplitNameInfoByNameKey, params.Page, params.Limit)
+}
+
+func queryNamesByPrefix(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryNamesParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.Prefix == "" {
+		return nil, sdkerrors.Wrap(types.ErrNameNotValid, "Prefix can not be empty.")
+	}
+
+	return queryNamesPage(ctx, k, k.GetNamesByPrefixIterator(ctx, params.Prefix), types.SplitNameInfoByNameKey, params.Page, params.Limit)
+}
+
+func queryNamesForSale(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryNamesParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return queryNamesPage(ctx, k, k.GetNamesForSaleIterator(ctx, params.Prefix), types.SplitNameForSaleKey, params.Page, params.Limit)
+}
+
+func queryExpiringNames(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryExpiringNamesParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.To.Before(params.From) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The end of the window can not be before its start.")
+	}
+
+	splitName := func(key []byte) string {
+		name, _ := types.SplitExpiredNameInfoQueueKey(key)
+		return name
+	}
+
+	return queryNamesPage(ctx, k, k.ExpiredNameInfoQueueRangeIterator(ctx, params.From, params.To), splitName, params.Page, params.Limit)
+}
+
+// queryNamesPage counts every key of the iterator but only loads the names on the requested page.
+func queryNamesPage(ctx sdk.Context, k Keeper, iterator sdk.Iterator, splitName func(key []byte) string, page int, limit int) ([]byte, error) {
+	defer iterator.Close()
+
+	if limit <= 0 {
+		limit = types.QueryDefaultLimit
+	}
+
+	// pages start at 1, other pages are empty
+	start := -1
+	if page > 0 {
+		start = (page - 1) * limit
+	}
+
+	total := 0
+	nameInfos := []types.NameInfo{}
+
+	for ; iterator.Valid(); iterator.Next() {
+		if start >= 0 && total >= start && total < start + limit {
+			nameInfo, found := k.GetNameInfo(ctx, splitName(iterator.Key()))
+			if found {
+				nameInfos = append(nameInfos, nameInfo)
+			}
+		}
+
+		total++
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewQueryResNamesPage(total, nameInfos))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryNameHistory(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryNameHistoryParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var entries []types.NameHistoryEntry
+
+	k.IterateNameHistory(ctx, params.Name, func(entry types.NameHistoryEntry) (stop bool) {
+		entries = append(entries, entry)
+		return false
+	})
+
+	total := len(entries)
+
+	start, end := client.Paginate(total, params.Page, params.Limit, types.QueryDefaultLimit)
+	if start < 0 || end < 0 {
+		entries = []types.NameHistoryEntry{}
+	} else {
+		entries = entries[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryResNameHistory{Total: total, Entries: entries})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryPendingTransfer(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	transfer, found := k.GetPendingTransfer(ctx, path[0])
+	if ! found {
+		return nil, types.ErrTransferNotFound
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, transfer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryPendingTransfers lists all pending transfers, or only those the address is sending or receiving when one is
+// given.
+func queryPendingTransfers(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var address sdk.AccAddress
+
+	if len(path) > 0 && path[0] != "" {
+		var err error
+		address, err = sdk.AccAddressFromBech32(path[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	transfers := types.QueryResPendingTransfers{}
+
+	k.IteratePendingTransfers(ctx, func(transfer types.PendingTransfer) (stop bool) {
+		if address.Empty() || address.Equals(transfer.Owner) || address.Equals(transfer.NewOwner) {
+			transfers = append(transfers, transfer)
+		}
+
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, transfers)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryFailedQueueItems(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	items := types.QueryResFailedQueueItems(k.GetFailedQueueItems(ctx))
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, items)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func queryNames(t *testing.T, input testInput, path string, params interface{}) types.QueryResNamesPage {
+	querier := NewQuerier(input.keeper)
+
+	res, err := querier(input.ctx, []string{path}, abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(params)})
+	require.NoError(t, err)
+
+	var page types.QueryResNamesPage
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(res, &page))
+
+	return page
+}
+
+func pageNames(page types.QueryResNamesPage) []string {
+	names := []string{}
+	for _, nameInfo := range page.Names {
+		names = append(names, nameInfo.Name)
+	}
+
+	return names
+}
+
+func TestNameQueriesArePaginated(t *testing.T) {
+	input := createTestInput(t)
+	owner := testAddrs[0]
+
+	for _, name := range []string{"alpha", "alphabet", "beta", "gamma", "alpine"} {
+		registerName(t, input, name, owner)
+	}
+
+	page := queryNames(t, input, QueryAllNames, types.NewQueryNamesParams(1, 2, ""))
+	require.Equal(t, 5, page.Total)
+	require.Equal(t, []string{"alpha", "alphabet"}, pageNames(page))
+
+	page = queryNames(t, input, QueryAllNames, types.NewQueryNamesParams(3, 2, ""))
+	require.Equal(t, 5, page.Total)
+	require.Equal(t, []string{"gamma"}, pageNames(page))
+
+	page = queryNames(t, input, QueryAllNames, types.NewQueryNamesParams(4, 2, ""))
+	require.Empty(t, page.Names)
+
+	page = queryNames(t, input, QueryNamesByPrefix, types.NewQueryNamesParams(1, 10, "alp"))
+	require.Equal(t, 3, page.Total)
+	require.Equal(t, []string{"alpha", "alphabet", "alpine"}, pageNames(page))
+
+	page = queryNames(t, input, QueryNamesByPrefix, types.NewQueryNamesParams(2, 1, "alpha"))
+	require.Equal(t, 2, page.Total)
+	require.Equal(t, []string{"alphabet"}, pageNames(page))
+
+	querier := NewQuerier(input.keeper)
+	_, err := querier(input.ctx, []string{QueryNamesByPrefix}, abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryNamesParams(1, 10, ""))})
+	require.Error(t, err)
+}
+
+func TestNamesForSaleQueryFollowsPrice(t *testing.T) {
+	input := createTestInput(t)
+	owner := testAddrs[0]
+
+	registerName(t, input, "forsale", owner)
+	registerName(t, input, "notforsale", owner)
+
+	require.NoError(t, input.keeper.HandleSetPrice(input.ctx, "forsale", owner, pin(100)))
+
+	page := queryNames(t, input, QueryNamesForSale, types.NewQueryNamesParams(1, 10, ""))
+	require.Equal(t, []string{"forsale"}, pageNames(page))
+
+	require.NoError(t, input.keeper.HandleSetPrice(input.ctx, "forsale", owner, nil))
+
+	page = queryNames(t, input, QueryNamesForSale, types.NewQueryNamesParams(1, 10, ""))
+	require.Equal(t, 0, page.Total)
+}
+
+func TestExpiringNamesQueryWindow(t *testing.T) {
+	input := createTestInput(t)
+	owner := testAddrs[0]
+
+	nameInfo := registerName(t, input, "expiring", owner)
+
+	page := queryNames(t, input, QueryExpiringNames, types.NewQueryExpiringNamesParams(1, 10, input.ctx.BlockTime(), nameInfo.ExpiryTime))
+	require.Equal(t, []string{"expiring"}, pageNames(page))
+
+	page = queryNames(t, input, QueryExpiringNames, types.NewQueryExpiringNamesParams(1, 10, input.ctx.BlockTime(), nameInfo.ExpiryTime.Add(-1)))
+	require.Equal(t, 0, page.Total)
+}
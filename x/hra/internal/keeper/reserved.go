@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+// RevokeName takes down a name through governance. Open auctions are settled without a sale so that all bids
+// are refunded, and the owner is cleaned up the same way as when the name expires. The name is reserved so that it
+// can't be registered again until it is released through governance.
+func (k Keeper) RevokeName(ctx sdk.Context, name string) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	k.SetReservedName(ctx, name)
+
+	if nameInfo.IsSubname() {
+		k.DeleteSubname(ctx, nameInfo)
+		k.AppendNameHistory(ctx, nameInfo, types.HistoryEventRevoke, nil, nil)
+
+		return nil
+	}
+
+	err := k.releaseName(ctx, nameInfo)
+	if err != nil {
+		return err
+	}
+
+	k.AppendNameHistory(ctx, nameInfo, types.HistoryEventRevoke, nil, nil)
+
+	auction, found := k.GetAuction(ctx, name)
+	if found {
+		return k.SettleAuction(ctx, auction)
+	}
+
+	return nil
+}
+
+func (k Keeper) SetReservedName(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetReservedNameKey(name), types.StatusPresent)
+}
+
+func (k Keeper) RemoveReservedName(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetReservedNameKey(name))
+}
+
+func (k Keeper) IsNameReserved(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetReservedNameKey(name))
+}
+
+func (k Keeper) GetReservedNamesIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.ReservedNameKeyPrefix)
+}
+
+func (k Keeper) IterateReservedNames(ctx sdk.Context, cb func(name string) (stop bool)) {
+	iterator := k.GetReservedNamesIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.SplitReservedNameKey(iterator.Key())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetReservedNames(ctx sdk.Context) []string {
+	var names []string
+	k.IterateReservedNames(ctx, func(name string) (stop bool) {
+		names = append(names, name)
+		return false
+	})
+
+	return names
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func TestReservedNamesCannotBeRegistered(t *testing.T) {
+	input := createTestInput(t)
+	owner := testAddrs[0]
+
+	input.keeper.SetReservedName(input.ctx, "reserved")
+	require.True(t, input.keeper.IsNameReserved(input.ctx, "reserved"))
+	require.Equal(t, []string{"reserved"}, input.keeper.GetReservedNames(input.ctx))
+
+	fund(t, input, owner, pin(1000000000000))
+	require.Equal(t, types.ErrNameReserved, input.keeper.HandleRegisterName(input.ctx, "reserved", owner, 1))
+
+	input.keeper.RemoveReservedName(input.ctx, "reserved")
+	require.False(t, input.keeper.IsNameReserved(input.ctx, "reserved"))
+	require.NoError(t, input.keeper.HandleRegisterName(input.ctx, "reserved", owner, 1))
+}
+
+func TestRevokeNameRefundsBidsAndReservesTheName(t *testing.T) {
+	input := createTestInput(t)
+	owner, bidder := testAddrs[0], testAddrs[1]
+
+	registerName(t, input, "revoked", owner)
+	require.NoError(t, input.keeper.HandleOpenAuction(input.ctx, "revoked", owner, types.AuctionTypeEnglish, pin(100), time.Hour))
+
+	fund(t, input, bidder, pin(500))
+	require.NoError(t, input.keeper.HandlePlaceBid(input.ctx, "revoked", bidder, pin(200)))
+
+	require.NoError(t, input.keeper.RevokeName(input.ctx, "revoked"))
+
+	require.False(t, input.keeper.IsNameRegistered(input.ctx, "revoked"))
+	require.True(t, input.keeper.IsNameReserved(input.ctx, "revoked"))
+	require.False(t, input.keeper.HasAuction(input.ctx, "revoked"))
+	require.Equal(t, pin(500), input.bankKeeper.GetCoins(input.ctx, bidder))
+	require.True(t, input.supplyKeeper.GetModuleAccount(input.ctx, types.AuctionEscrowModuleName).GetCoins().IsZero())
+
+	fund(t, input, bidder, pin(1000000000000))
+	require.Equal(t, types.ErrNameReserved, input.keeper.HandleRegisterName(input.ctx, "revoked", bidder, 1))
+
+	require.Equal(t, types.ErrNameNotRegistered, input.keeper.RevokeName(input.ctx, "missing"))
+}
@@ -0,0 +1,323 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+	"strings"
+	"time"
+)
+
+// Subnames are not added to the status mapping of their owner, so they neither grant address credits
+// nor trigger the name hooks. Only top level names count towards HRA holder rewards.
+func (k Keeper) HandleRegisterSubname(ctx sdk.Context, name string, owner sdk.AccAddress, subnameOwner sdk.AccAddress) error {
+	if k.IsNameRegistered(ctx, name) {
+		return types.ErrNameRegistered
+	}
+
+	if k.IsNameReserved(ctx, name) {
+		return types.ErrNameReserved
+	}
+
+	_, parentName := types.SplitSubname(name)
+
+	parent, found := k.GetNameInfo(ctx, parentName)
+	if ! found {
+		return types.ErrParentNotRegistered
+	}
+
+	if ! owner.Equals(parent.Owner) {
+		return types.ErrNotOwner
+	}
+
+	if k.IsNameExpired(ctx, parent) {
+		return types.ErrNameExpired
+	}
+
+	err := k.SupplyKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		owner,
+		k.feeCollectorName,
+		k.SubnameRegistrationFee(ctx),
+	)
+	if err != nil {
+		return err
+	}
+
+	nameInfo := types.NewNameInfo(name)
+
+	nameInfo.Owner = subnameOwner
+	nameInfo.CreationTime = ctx.BlockTime()
+	nameInfo.ExpiryTime = parent.ExpiryTime
+	nameInfo.Parent = parentName
+
+	k.SetNameInfo(ctx, name, nameInfo)
+	k.SetSubname(ctx, parentName, name)
+	k.AppendNameHistory(ctx, nameInfo, types.HistoryEventRegister, nil, nil)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterSubname,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyParent, parentName),
+			sdk.NewAttribute(types.AttributeKeyOwner, subnameOwner.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) HandleRegisterSubnameAddress(ctx sdk.Context, name string, owner sdk.AccAddress, blockchainId string, index string, blockchainAddress string) error {
+	blockchainAddress = strings.TrimSpace(blockchainAddress)
+
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! nameInfo.IsSubname() {
+		return sdkerrors.Wrap(types.ErrNameNotValid, "Not a subname.")
+	}
+
+	if ! k.IsOwnerOrOperator(ctx, nameInfo, owner) {
+		return types.ErrNotOwner
+	}
+
+	if k.IsNameExpired(ctx, nameInfo) {
+		return types.ErrNameExpired
+	}
+
+	return k.registerNameAddress(ctx, name, owner, blockchainId, index, blockchainAddress)
+}
+
+// registerNameAddress sets a blockchain address record of the name itself rather than of its owner's account. Address
+// credits only cover the records of an account, so the payer is always charged the address registration fee.
+func (k Keeper) registerNameAddress(ctx sdk.Context, name string, payer sdk.AccAddress, blockchainId string, index string, blockchainAddress string) error {
+	if ! k.IsBlockchainIdRegistered(ctx, blockchainId) {
+		return types.ErrBlockchainIdNotValid
+	}
+
+	if err := k.ValidateBlockchainAddress(ctx, blockchainId, blockchainAddress); err != nil {
+		return err
+	}
+
+	err := k.SupplyKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		payer,
+		k.feeCollectorName,
+		k.AddressRegistrationFee(ctx),
+	)
+	if err != nil {
+		return err
+	}
+
+	k.SetNameAddress(ctx, name, blockchainId, index, blockchainAddress)
+
+	return nil
+}
+
+func (k Keeper) HandleRemoveSubnameAddress(ctx sdk.Context, name string, owner sdk.AccAddress, blockchainId string, index string) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! k.IsOwnerOrOperator(ctx, nameInfo, owner) {
+		return types.ErrNotOwner
+	}
+
+	k.RemoveNameAddress(ctx, name, blockchainId, index)
+
+	return nil
+}
+
+// Both the owner of the subname and the owner of the parent name can delete a subname.
+func (k Keeper) handleDeleteSubname(ctx sdk.Context, nameInfo types.NameInfo, owner sdk.AccAddress) error {
+	if ! owner.Equals(nameInfo.Owner) {
+		parent, found := k.GetNameInfo(ctx, nameInfo.Parent)
+		if ! found || ! owner.Equals(parent.Owner) {
+			return types.ErrNotOwner
+		}
+	}
+
+	k.DeleteSubname(ctx, nameInfo)
+	k.AppendNameHistory(ctx, nameInfo, types.HistoryEventDelete, nil, nil)
+
+	return nil
+}
+
+// The blockchain addresses, text records and operators of a subname belong to its owner, so they are cleared when the subname changes hands.
+func (k Keeper) changeSubnameOwner(ctx sdk.Context, nameInfo types.NameInfo, newOwner sdk.AccAddress) {
+	k.ClearPrimaryName(ctx, nameInfo.Owner, nameInfo.Name)
+
+	oldOwner := nameInfo.Owner
+	nameInfo.Owner = newOwner
+
+	k.SetNameInfo(ctx, nameInfo.Name, nameInfo)
+	k.AppendNameHistory(ctx, nameInfo, types.HistoryEventTransfer, oldOwner, nil)
+	k.RemoveAllNameAddresses(ctx, nameInfo.Name)
+	k.RemoveAllTextRecords(ctx, nameInfo.Name)
+	k.RemoveAllOperators(ctx, nameInfo.Name)
+}
+
+// DeleteSubname removes the subname together with its blockchain address records.
+func (k Keeper) DeleteSubname(ctx sdk.Context, nameInfo types.NameInfo) {
+	k.DeleteNameInfo(ctx, nameInfo.Name)
+	k.RemoveSubname(ctx, nameInfo.Parent, nameInfo.Name)
+	k.RemoveAllNameAddresses(ctx, nameInfo.Name)
+	k.ClearPrimaryName(ctx, nameInfo.Owner, nameInfo.Name)
+	k.RemoveAllTextRecords(ctx, nameInfo.Name)
+	k.RemoveAllOperators(ctx, nameInfo.Name)
+	k.ClearPendingTransfer(ctx, nameInfo.Name)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteSubname,
+			sdk.NewAttribute(types.AttributeKeyName, nameInfo.Name),
+			sdk.NewAttribute(types.AttributeKeyParent, nameInfo.Parent),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+}
+
+// DeleteSubnames removes all subnames of a parent that is being deleted or has expired.
+func (k Keeper) DeleteSubnames(ctx sdk.Context, parent string) {
+	for _, name := range k.GetSubnames(ctx, parent) {
+		nameInfo, found := k.GetNameInfo(ctx, name)
+		if found {
+			k.DeleteSubname(ctx, nameInfo)
+			k.AppendNameHistory(ctx, nameInfo, types.HistoryEventDelete, nil, nil)
+		}
+	}
+}
+
+// RenewSubnames keeps the expiry time of all subnames in sync with their parent.
+func (k Keeper) RenewSubnames(ctx sdk.Context, parent string, expiryTime time.Time) {
+	for _, name := range k.GetSubnames(ctx, parent) {
+		nameInfo, found := k.GetNameInfo(ctx, name)
+		if found {
+			nameInfo.ExpiryTime = expiryTime
+
+			k.SetNameInfo(ctx, name, nameInfo)
+			k.AppendNameHistory(ctx, nameInfo, types.HistoryEventRenew, nil, nil)
+		}
+	}
+}
+
+func (k Keeper) SetSubname(ctx sdk.Context, parent string, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetSubnameKey(parent, name), types.StatusPresent)
+}
+
+func (k Keeper) RemoveSubname(ctx sdk.Context, parent string, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetSubnameKey(parent, name))
+}
+
+func (k Keeper) GetSubnamesIterator(ctx sdk.Context, parent string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetSubnameIteratorKey(parent))
+}
+
+func (k Keeper) IterateSubnames(ctx sdk.Context, parent string, cb func(name string) (stop bool)) {
+	iterator := k.GetSubnamesIterator(ctx, parent)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		name := types.SplitSubnameKey(iterator.Key())
+
+		if cb(name) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetSubnames(ctx sdk.Context, parent string) []string {
+	var names []string
+	k.IterateSubnames(ctx, parent, func(name string) (stop bool) {
+		names = append(names, name)
+		return false
+	})
+
+	return names
+}
+
+func (k Keeper) SetNameAddress(ctx sdk.Context, name string, blockchainId string, index string, blockchainAddress string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetNameAddressKey(name, blockchainId, index), []byte(blockchainAddress))
+}
+
+func (k Keeper) RemoveNameAddress(ctx sdk.Context, name string, blockchainId string, index string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetNameAddressKey(name, blockchainId, index))
+}
+
+func (k Keeper) GetNameAddress(ctx sdk.Context, name string, blockchainId string, index string) (string, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	blockchainAddress := store.Get(types.GetNameAddressKey(name, blockchainId, index))
+
+	if blockchainAddress == nil {
+		return "", types.ErrBlockchainAddressNotFound
+	}
+
+	return string(blockchainAddress), nil
+}
+
+func (k Keeper) GetNameAddressIterator(ctx sdk.Context, name string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetNameAddressIteratorKey(name))
+}
+
+func (k Keeper) RemoveAllNameAddresses(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := k.GetNameAddressIterator(ctx, name)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
+
+func (k Keeper) IterateNameAddressInfos(ctx sdk.Context, name string, cb func(blockchainAddress types.BlockchainAddressInfo) (stop bool)) {
+	iterator := k.GetNameAddressIterator(ctx, name)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.SplitNameAddressKey(iterator.Key())
+		record.BlockchainAddressInfo.BlockchainAddress = string(iterator.Value())
+
+		if cb(record.BlockchainAddressInfo) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAllNameAddressInfos(ctx sdk.Context, cb func(nameAddressRecord types.NameAddressRecordInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.NameAddressKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.SplitNameAddressKey(iterator.Key())
+		record.BlockchainAddressInfo.BlockchainAddress = string(iterator.Value())
+
+		if cb(record) {
+			break
+		}
+	}
+}
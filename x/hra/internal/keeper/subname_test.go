@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func TestSubnamesFollowTheirParent(t *testing.T) {
+	input := createTestInput(t)
+	owner, holder, other := testAddrs[0], testAddrs[1], testAddrs[2]
+
+	parent := registerName(t, input, "parent", owner)
+
+	fund(t, input, owner, input.keeper.SubnameRegistrationFee(input.ctx))
+	fund(t, input, other, input.keeper.SubnameRegistrationFee(input.ctx))
+
+	require.Equal(t, types.ErrNotOwner, input.keeper.HandleRegisterSubname(input.ctx, "pay.parent", other, other))
+	require.Equal(t, types.ErrParentNotRegistered, input.keeper.HandleRegisterSubname(input.ctx, "pay.missing", owner, holder))
+	require.NoError(t, input.keeper.HandleRegisterSubname(input.ctx, "pay.parent", owner, holder))
+
+	subname, found := input.keeper.GetNameInfo(input.ctx, "pay.parent")
+	require.True(t, found)
+	require.Equal(t, holder, subname.Owner)
+	require.Equal(t, parent.ExpiryTime, subname.ExpiryTime)
+	require.Equal(t, []string{"pay.parent"}, input.keeper.GetSubnames(input.ctx, "parent"))
+
+	// dotted names can only be created as subnames, whether or not their parent is registered
+	fund(t, input, other, pin(1000000000000))
+	err := input.keeper.HandleRegisterName(input.ctx, "dev.parent", other, 1)
+	require.True(t, types.ErrNameNotValid.Is(err), err)
+	err = input.keeper.HandleRegisterName(input.ctx, "pay.unregistered", other, 1)
+	require.True(t, types.ErrNameNotValid.Is(err), err)
+	require.False(t, input.keeper.IsNameRegistered(input.ctx, "pay.unregistered"))
+
+	// renewing the parent renews its subnames
+	fee, err := input.keeper.GetRenewalFee(input.ctx, "parent", 1)
+	require.NoError(t, err)
+	fund(t, input, owner, fee)
+	require.NoError(t, input.keeper.HandleRenewName(input.ctx, "parent", owner, 1))
+
+	subname, _ = input.keeper.GetNameInfo(input.ctx, "pay.parent")
+	renewed, _ := input.keeper.GetNameInfo(input.ctx, "parent")
+	require.Equal(t, renewed.ExpiryTime, subname.ExpiryTime)
+
+	// releasing the parent releases its subnames
+	released := input.ctx.WithBlockTime(input.keeper.RedemptionEndTime(input.ctx, renewed))
+	require.NoError(t, input.keeper.DeleteExpiredNameInfo(released, renewed))
+	require.False(t, input.keeper.IsNameRegistered(released, "pay.parent"))
+	require.Empty(t, input.keeper.GetSubnames(released, "parent"))
+}
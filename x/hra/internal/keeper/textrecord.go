@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func (k Keeper) HandleSetTextRecord(ctx sdk.Context, name string, owner sdk.AccAddress, key string, value string) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! owner.Equals(nameInfo.Owner) {
+		return types.ErrNotOwner
+	}
+
+	if k.IsNameExpired(ctx, nameInfo) {
+		return types.ErrNameExpired
+	}
+
+	maxValueLength := k.TextRecordMaxValueLength(ctx)
+	if uint64(len(value)) > maxValueLength {
+		return sdkerrors.Wrap(types.ErrTextRecordValueNotValid, fmt.Sprintf("value exceeds %d bytes", maxValueLength))
+	}
+
+	// overwriting an existing key does not count towards the limit and is free
+	if ! k.HasTextRecord(ctx, name, key) {
+		maxCount := k.TextRecordMaxCount(ctx)
+		if k.GetTextRecordCount(ctx, name) >= maxCount {
+			return sdkerrors.Wrap(types.ErrTextRecordLimitExceeded, fmt.Sprintf("a name can hold at most %d text records", maxCount))
+		}
+
+		err := k.SupplyKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			owner,
+			k.feeCollectorName,
+			k.TextRecordFee(ctx),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.SetTextRecord(ctx, name, key, value)
+
+	return nil
+}
+
+func (k Keeper) HandleRemoveTextRecord(ctx sdk.Context, name string, owner sdk.AccAddress, key string) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! owner.Equals(nameInfo.Owner) {
+		return types.ErrNotOwner
+	}
+
+	if ! k.HasTextRecord(ctx, name, key) {
+		return types.ErrTextRecordNotFound
+	}
+
+	k.RemoveTextRecord(ctx, name, key)
+
+	return nil
+}
+
+func (k Keeper) SetTextRecord(ctx sdk.Context, name string, key string, value string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetTextRecordKey(name, key), []byte(value))
+}
+
+func (k Keeper) RemoveTextRecord(ctx sdk.Context, name string, key string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetTextRecordKey(name, key))
+}
+
+func (k Keeper) HasTextRecord(ctx sdk.Context, name string, key string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetTextRecordKey(name, key))
+}
+
+func (k Keeper) GetTextRecord(ctx sdk.Context, name string, key string) (types.TextRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetTextRecordKey(name, key))
+	if value == nil {
+		return types.TextRecord{}, types.ErrTextRecordNotFound
+	}
+
+	return types.NewTextRecord(name, key, string(value)), nil
+}
+
+func (k Keeper) GetTextRecordIterator(ctx sdk.Context, name string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetTextRecordIteratorKey(name))
+}
+
+func (k Keeper) GetTextRecordCount(ctx sdk.Context, name string) uint64 {
+	iterator := k.GetTextRecordIterator(ctx, name)
+
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+
+	return count
+}
+
+// RemoveAllTextRecords is called whenever the name is deleted or changes hands so that
+// the records of the previous owner are not exposed under the new one.
+func (k Keeper) RemoveAllTextRecords(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := k.GetTextRecordIterator(ctx, name)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
+
+func (k Keeper) IterateTextRecords(ctx sdk.Context, name string, cb func(textRecord types.TextRecord) (stop bool)) {
+	iterator := k.GetTextRecordIterator(ctx, name)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		recordName, key := types.SplitTextRecordKey(iterator.Key())
+
+		if cb(types.NewTextRecord(recordName, key, string(iterator.Value()))) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAllTextRecords(ctx sdk.Context, cb func(textRecord types.TextRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TextRecordKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		recordName, key := types.SplitTextRecordKey(iterator.Key())
+
+		if cb(types.NewTextRecord(recordName, key, string(iterator.Value()))) {
+			break
+		}
+	}
+}
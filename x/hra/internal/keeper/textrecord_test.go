@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func TestTextRecordLimits(t *testing.T) {
+	input := createTestInput(t)
+	owner, other := testAddrs[0], testAddrs[1]
+
+	registerName(t, input, "records", owner)
+
+	params := input.keeper.GetParams(input.ctx)
+	params.TextRecordMaxCount = 2
+	params.TextRecordMaxValueLength = 8
+	input.keeper.SetParams(input.ctx, params)
+
+	fund(t, input, owner, pin(1000000000000))
+
+	require.Equal(t, types.ErrNotOwner, input.keeper.HandleSetTextRecord(input.ctx, "records", other, "url", "a"))
+	require.True(t, types.ErrTextRecordValueNotValid.Is(input.keeper.HandleSetTextRecord(input.ctx, "records", owner, "url", strings.Repeat("a", 9))))
+
+	require.NoError(t, input.keeper.HandleSetTextRecord(input.ctx, "records", owner, "url", "a"))
+	require.NoError(t, input.keeper.HandleSetTextRecord(input.ctx, "records", owner, "email", "b"))
+	require.True(t, types.ErrTextRecordLimitExceeded.Is(input.keeper.HandleSetTextRecord(input.ctx, "records", owner, "avatar", "c")))
+
+	// overwriting a key does not count towards the limit and is not charged
+	balance := input.bankKeeper.GetCoins(input.ctx, owner)
+	require.NoError(t, input.keeper.HandleSetTextRecord(input.ctx, "records", owner, "url", "changed"))
+	require.Equal(t, balance, input.bankKeeper.GetCoins(input.ctx, owner))
+
+	record, err := input.keeper.GetTextRecord(input.ctx, "records", "url")
+	require.NoError(t, err)
+	require.Equal(t, "changed", record.Value)
+
+	require.NoError(t, input.keeper.HandleRemoveTextRecord(input.ctx, "records", owner, "email"))
+	require.Equal(t, types.ErrTextRecordNotFound, input.keeper.HandleRemoveTextRecord(input.ctx, "records", owner, "email"))
+	require.Equal(t, uint64(1), input.keeper.GetTextRecordCount(input.ctx, "records"))
+}
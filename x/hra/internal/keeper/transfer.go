@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+	"time"
+)
+
+// HandleStartTransfer locks the name and schedules its transfer to the new owner after the transfer delay.
+func (k Keeper) HandleStartTransfer(ctx sdk.Context, name string, owner sdk.AccAddress, newOwner sdk.AccAddress) (types.PendingTransfer, error) {
+	_, err := k.validateTransfer(ctx, name, owner, newOwner)
+	if err != nil {
+		return types.PendingTransfer{}, err
+	}
+
+	transfer := types.NewPendingTransfer(name, owner, newOwner, ctx.BlockTime().Add(k.TransferDelay(ctx)))
+
+	k.SetPendingTransfer(ctx, transfer)
+
+	return transfer, nil
+}
+
+func (k Keeper) HandleCancelTransfer(ctx sdk.Context, name string, owner sdk.AccAddress) error {
+	transfer, found := k.GetPendingTransfer(ctx, name)
+	if ! found {
+		return types.ErrTransferNotFound
+	}
+
+	if ! owner.Equals(transfer.Owner) {
+		return types.ErrNotOwner
+	}
+
+	k.DeletePendingTransfer(ctx, transfer)
+
+	return nil
+}
+
+// CompletePendingTransfer hands the name over once the transfer delay has passed. The transfer is dropped without
+// effect if the name changed hands or expired in the meantime.
+func (k Keeper) CompletePendingTransfer(ctx sdk.Context, transfer types.PendingTransfer) error {
+	k.DeletePendingTransfer(ctx, transfer)
+
+	nameInfo, found := k.GetNameInfo(ctx, transfer.Name)
+	if ! found || ! nameInfo.Owner.Equals(transfer.Owner) || k.IsNameExpired(ctx, nameInfo) {
+		return nil
+	}
+
+	err := k.transferName(ctx, nameInfo, transfer.NewOwner)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteTransfer,
+			sdk.NewAttribute(types.AttributeKeyName, transfer.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, transfer.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, transfer.NewOwner.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) GetPendingTransfer(ctx sdk.Context, name string) (types.PendingTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetPendingTransferKey(name))
+	if bz == nil {
+		return types.PendingTransfer{}, false
+	}
+
+	var transfer types.PendingTransfer
+	k.cdc.MustUnmarshalBinaryBare(bz, &transfer)
+
+	return transfer, true
+}
+
+// SetPendingTransfer stores the transfer together with its completion queue entry.
+func (k Keeper) SetPendingTransfer(ctx sdk.Context, transfer types.PendingTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetPendingTransferKey(transfer.Name), k.cdc.MustMarshalBinaryBare(transfer))
+	store.Set(types.PendingTransferQueueKey(transfer.Name, transfer.CompletionTime), []byte(transfer.Name))
+}
+
+func (k Keeper) DeletePendingTransfer(ctx sdk.Context, transfer types.PendingTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetPendingTransferKey(transfer.Name))
+	k.RemoveFromPendingTransferQueue(ctx, transfer)
+}
+
+func (k Keeper) RemoveFromPendingTransferQueue(ctx sdk.Context, transfer types.PendingTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.PendingTransferQueueKey(transfer.Name, transfer.CompletionTime))
+}
+
+// ClearPendingTransfer drops the pending transfer of a name that is being removed, if there is one.
+func (k Keeper) ClearPendingTransfer(ctx sdk.Context, name string) {
+	transfer, found := k.GetPendingTransfer(ctx, name)
+	if found {
+		k.DeletePendingTransfer(ctx, transfer)
+	}
+}
+
+func (k Keeper) HasPendingTransfer(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetPendingTransferKey(name))
+}
+
+func (k Keeper) IteratePendingTransfers(ctx sdk.Context, cb func(transfer types.PendingTransfer) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingTransferKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.PendingTransfer
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &transfer)
+
+		if cb(transfer) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IteratePendingTransferQueue(ctx sdk.Context, endTime time.Time, cb func(name string) (stop bool)) {
+	iterator := k.PendingTransferQueueIterator(ctx, endTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		name, _ := types.SplitPendingTransferQueueKey(iterator.Key())
+
+		if cb(name) {
+			break
+		}
+	}
+}
+
+func (k Keeper) PendingTransferQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.PendingTransferQueueKeyPrefix, sdk.PrefixEndBytes(types.PendingTransferByTimeKey(endTime)))
+}
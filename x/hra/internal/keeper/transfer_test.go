@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/hra/internal/types"
+)
+
+func TestPendingTransferLocksTheNameUntilCompleted(t *testing.T) {
+	input := createTestInput(t)
+	owner, newOwner, other := testAddrs[0], testAddrs[1], testAddrs[2]
+
+	registerName(t, input, "locked", owner)
+
+	transfer, err := input.keeper.HandleStartTransfer(input.ctx, "locked", owner, newOwner)
+	require.NoError(t, err)
+	require.Equal(t, input.ctx.BlockTime().Add(input.keeper.TransferDelay(input.ctx)), transfer.CompletionTime)
+
+	_, err = input.keeper.HandleStartTransfer(input.ctx, "locked", owner, other)
+	require.Equal(t, types.ErrTransferPending, err)
+	require.Equal(t, types.ErrTransferPending, input.keeper.HandleTransferName(input.ctx, "locked", owner, other))
+	require.Equal(t, types.ErrTransferPending, input.keeper.HandleSetPrice(input.ctx, "locked", owner, pin(100)))
+
+	completed := input.ctx.WithBlockTime(transfer.CompletionTime)
+	require.NoError(t, input.keeper.CompletePendingTransfer(completed, transfer))
+
+	nameInfo, _ := input.keeper.GetNameInfo(completed, "locked")
+	require.Equal(t, newOwner, nameInfo.Owner)
+	require.False(t, input.keeper.HasPendingTransfer(completed, "locked"))
+}
+
+func TestCancelledTransferReleasesTheName(t *testing.T) {
+	input := createTestInput(t)
+	owner, newOwner := testAddrs[0], testAddrs[1]
+
+	registerName(t, input, "cancelled", owner)
+
+	_, err := input.keeper.HandleStartTransfer(input.ctx, "cancelled", owner, newOwner)
+	require.NoError(t, err)
+
+	require.Equal(t, types.ErrNotOwner, input.keeper.HandleCancelTransfer(input.ctx, "cancelled", newOwner))
+	require.NoError(t, input.keeper.HandleCancelTransfer(input.ctx, "cancelled", owner))
+	require.Equal(t, types.ErrTransferNotFound, input.keeper.HandleCancelTransfer(input.ctx, "cancelled", owner))
+
+	require.False(t, input.keeper.HasPendingTransfer(input.ctx, "cancelled"))
+	require.NoError(t, input.keeper.HandleSetPrice(input.ctx, "cancelled", owner, pin(100)))
+}
+
+func TestPendingTransferIsDroppedWhenTheNameExpires(t *testing.T) {
+	input := createTestInput(t)
+	owner, newOwner := testAddrs[0], testAddrs[1]
+
+	nameInfo := registerName(t, input, "dropped", owner)
+
+	transfer, err := input.keeper.HandleStartTransfer(input.ctx, "dropped", owner, newOwner)
+	require.NoError(t, err)
+
+	expired := input.ctx.WithBlockTime(nameInfo.ExpiryTime)
+	require.NoError(t, input.keeper.CompletePendingTransfer(expired, transfer))
+
+	nameInfo, _ = input.keeper.GetNameInfo(expired, "dropped")
+	require.Equal(t, owner, nameInfo.Owner)
+	require.False(t, input.keeper.HasPendingTransfer(expired, "dropped"))
+}
@@ -0,0 +1,359 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/crypto/sha3"
+)
+
+// Address validator kinds which can be assigned to a blockchain id
+const (
+	AddressValidatorLength   = "length"
+	AddressValidatorBitcoin  = "bitcoin"
+	AddressValidatorLitecoin = "litecoin"
+	AddressValidatorEthereum = "ethereum"
+	AddressValidatorCosmos   = "cosmos"
+	AddressValidatorRipple   = "ripple"
+)
+
+// AddressValidator checks the format of a blockchain address. The generic length check is always applied beforehand.
+type AddressValidator func(blockchainAddress string) error
+
+var addressValidators = map[string]AddressValidator{
+	AddressValidatorLength:   func(string) error { return nil },
+	AddressValidatorBitcoin:  validateBitcoinAddress,
+	AddressValidatorLitecoin: validateLitecoinAddress,
+	AddressValidatorEthereum: validateEthereumAddress,
+	AddressValidatorCosmos:   validateCosmosAddress,
+	AddressValidatorRipple:   validateRippleAddress,
+}
+
+// DefaultBlockchainIdAddressValidators maps the default registered blockchain ids to their validator kind.
+// Blockchain ids without an entry, and without a validator assigned through governance, only get the length check.
+var DefaultBlockchainIdAddressValidators = map[string]string{
+	"btc":  AddressValidatorBitcoin,
+	"ltc":  AddressValidatorLitecoin,
+	"eth":  AddressValidatorEthereum,
+	"omg":  AddressValidatorEthereum,
+	"tusd": AddressValidatorEthereum,
+	"zrx":  AddressValidatorEthereum,
+	"atom": AddressValidatorCosmos,
+	"xrp":  AddressValidatorRipple,
+}
+
+// RegisterAddressValidator makes a new validator kind available to governance. It must be called before the chain starts.
+func RegisterAddressValidator(kind string, validator AddressValidator) {
+	if _, found := addressValidators[kind]; found {
+		panic(fmt.Sprintf("address validator %s already registered", kind))
+	}
+
+	addressValidators[kind] = validator
+}
+
+func IsValidAddressValidator(kind string) bool {
+	_, found := addressValidators[kind]
+
+	return found
+}
+
+func validateAddressValidator(kind string) error {
+	if ! IsValidAddressValidator(kind) {
+		return sdkerrors.Wrap(ErrAddressValidatorNotValid, kind)
+	}
+
+	return nil
+}
+
+// ValidateBlockchainAddressWith runs the length check followed by the validator of the given kind.
+// Unknown kinds fall back to the length check.
+func ValidateBlockchainAddressWith(kind string, blockchainAddress string) error {
+	if err := validateBlockchainAddress(blockchainAddress); err != nil {
+		return err
+	}
+
+	validator, found := addressValidators[kind]
+	if ! found {
+		return nil
+	}
+
+	if err := validator(blockchainAddress); err != nil {
+		return sdkerrors.Wrapf(ErrBlockchainAddressNotValid, "invalid %s address: %s", kind, err.Error())
+	}
+
+	return nil
+}
+
+// Bitcoin: base58check P2PKH/P2SH or bech32 segwit
+func validateBitcoinAddress(blockchainAddress string) error {
+	if strings.HasPrefix(strings.ToLower(blockchainAddress), "bc1") {
+		return validateSegwitAddress("bc", blockchainAddress)
+	}
+
+	return validateBase58CheckAddress(blockchainAddress, 0x00, 0x05)
+}
+
+// Litecoin: base58check P2PKH/P2SH (including the legacy 3 prefix) or bech32 segwit
+func validateLitecoinAddress(blockchainAddress string) error {
+	if strings.HasPrefix(strings.ToLower(blockchainAddress), "ltc1") {
+		return validateSegwitAddress("ltc", blockchainAddress)
+	}
+
+	return validateBase58CheckAddress(blockchainAddress, 0x30, 0x32, 0x05)
+}
+
+var ethereumAddress = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`).MatchString
+
+// Ethereum: hex address, mixed case addresses must carry a valid EIP-55 checksum
+func validateEthereumAddress(blockchainAddress string) error {
+	if ! ethereumAddress(blockchainAddress) {
+		return fmt.Errorf("expected 0x followed by 40 hex characters")
+	}
+
+	address := blockchainAddress[2:]
+
+	if address == strings.ToLower(address) || address == strings.ToUpper(address) {
+		return nil
+	}
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(strings.ToLower(address)))
+	digest := hex.EncodeToString(hash.Sum(nil))
+
+	for i, c := range address {
+		if c >= '0' && c <= '9' {
+			continue
+		}
+
+		upper := digest[i] >= '8'
+		if upper != (c >= 'A' && c <= 'F') {
+			return fmt.Errorf("invalid EIP-55 checksum")
+		}
+	}
+
+	return nil
+}
+
+// Cosmos: bech32 with the cosmos prefix
+func validateCosmosAddress(blockchainAddress string) error {
+	hrp, data, _, err := decodeBech32(blockchainAddress)
+	if err != nil {
+		return err
+	}
+
+	if hrp != "cosmos" {
+		return fmt.Errorf("expected cosmos prefix, got %s", hrp)
+	}
+
+	program, err := convertBits(data, 5, 8, false)
+	if err != nil {
+		return err
+	}
+
+	if len(program) != 20 && len(program) != 32 {
+		return fmt.Errorf("invalid address length %d", len(program))
+	}
+
+	return nil
+}
+
+const (
+	bitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	rippleAlphabet  = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+)
+
+// Ripple: base58check classic address using the ripple alphabet
+func validateRippleAddress(blockchainAddress string) error {
+	if ! strings.HasPrefix(blockchainAddress, "r") {
+		return fmt.Errorf("expected r prefix")
+	}
+
+	// ripple uses the same encoding as bitcoin with a different alphabet
+	translated := make([]byte, len(blockchainAddress))
+	for i := 0; i < len(blockchainAddress); i++ {
+		index := strings.IndexByte(rippleAlphabet, blockchainAddress[i])
+		if index < 0 {
+			return fmt.Errorf("invalid character %q", blockchainAddress[i])
+		}
+
+		translated[i] = bitcoinAlphabet[index]
+	}
+
+	return validateBase58CheckAddress(string(translated), 0x00)
+}
+
+func validateBase58CheckAddress(blockchainAddress string, versions ...byte) error {
+	payload, version, err := base58.CheckDecode(blockchainAddress)
+	if err != nil {
+		return err
+	}
+
+	if len(payload) != 20 {
+		return fmt.Errorf("invalid payload length %d", len(payload))
+	}
+
+	for _, v := range versions {
+		if version == v {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unexpected version byte %d", version)
+}
+
+// validateSegwitAddress checks a BIP-173 (witness version 0) or BIP-350 (witness version 1+) address
+func validateSegwitAddress(expectedHrp string, blockchainAddress string) error {
+	hrp, data, bech32m, err := decodeBech32(blockchainAddress)
+	if err != nil {
+		return err
+	}
+
+	if hrp != expectedHrp {
+		return fmt.Errorf("expected %s prefix, got %s", expectedHrp, hrp)
+	}
+
+	if len(data) == 0 {
+		return fmt.Errorf("empty witness program")
+	}
+
+	witnessVersion := data[0]
+	if witnessVersion > 16 {
+		return fmt.Errorf("invalid witness version %d", witnessVersion)
+	}
+
+	if (witnessVersion == 0) == bech32m {
+		return fmt.Errorf("invalid checksum variant for witness version %d", witnessVersion)
+	}
+
+	program, err := convertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return err
+	}
+
+	if len(program) < 2 || len(program) > 40 {
+		return fmt.Errorf("invalid witness program length %d", len(program))
+	}
+
+	if witnessVersion == 0 && len(program) != 20 && len(program) != 32 {
+		return fmt.Errorf("invalid witness program length %d", len(program))
+	}
+
+	return nil
+}
+
+const (
+	bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	bech32Const   = 1
+	bech32mConst  = 0x2bc830a3
+)
+
+// decodeBech32 decodes both bech32 and bech32m strings and reports which checksum variant was used
+func decodeBech32(encoded string) (hrp string, data []byte, bech32m bool, err error) {
+	if len(encoded) < 8 || len(encoded) > 90 {
+		return "", nil, false, fmt.Errorf("invalid bech32 length %d", len(encoded))
+	}
+
+	if strings.ToLower(encoded) != encoded && strings.ToUpper(encoded) != encoded {
+		return "", nil, false, fmt.Errorf("mixed case bech32 string")
+	}
+
+	encoded = strings.ToLower(encoded)
+
+	separator := strings.LastIndexByte(encoded, '1')
+	if separator < 1 || separator+7 > len(encoded) {
+		return "", nil, false, fmt.Errorf("invalid bech32 separator position")
+	}
+
+	hrp = encoded[:separator]
+
+	values := make([]byte, 0, len(encoded)-separator-1)
+	for i := separator + 1; i < len(encoded); i++ {
+		index := strings.IndexByte(bech32Charset, encoded[i])
+		if index < 0 {
+			return "", nil, false, fmt.Errorf("invalid bech32 character %q", encoded[i])
+		}
+
+		values = append(values, byte(index))
+	}
+
+	switch bech32Polymod(append(bech32HrpExpand(hrp), values...)) {
+	case bech32Const:
+		bech32m = false
+	case bech32mConst:
+		bech32m = true
+	default:
+		return "", nil, false, fmt.Errorf("invalid bech32 checksum")
+	}
+
+	return hrp, values[:len(values)-6], bech32m, nil
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+
+	return chk
+}
+
+func bech32HrpExpand(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+
+	expanded = append(expanded, 0)
+
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+
+	return expanded
+}
+
+func convertBits(data []byte, fromBits uint, toBits uint, pad bool) ([]byte, error) {
+	var result []byte
+
+	acc := uint32(0)
+	bits := uint(0)
+	maxValue := uint32(1<<toBits) - 1
+
+	for _, value := range data {
+		if uint32(value)>>fromBits != 0 {
+			return nil, fmt.Errorf("invalid data range")
+		}
+
+		acc = acc<<fromBits | uint32(value)
+		bits += fromBits
+
+		for bits >= toBits {
+			bits -= toBits
+			result = append(result, byte(acc>>bits&maxValue))
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			result = append(result, byte(acc<<(toBits-bits)&maxValue))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxValue != 0 {
+		return nil, fmt.Errorf("invalid padding")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"time"
+)
+
+const (
+	AuctionTypeEnglish = "english"
+	AuctionTypeSealed  = "sealed"
+)
+
+type Auction struct {
+	Name          string         `json:"name" yaml:"name"`
+	Owner         sdk.AccAddress `json:"owner" yaml:"owner"`
+	Type          string         `json:"type" yaml:"type"`
+	ReservePrice  sdk.Coins      `json:"reserve_price" yaml:"reserve_price"`
+	StartTime     time.Time      `json:"start_time" yaml:"start_time"`
+	EndTime       time.Time      `json:"end_time" yaml:"end_time"`
+	RevealEndTime time.Time      `json:"reveal_end_time" yaml:"reveal_end_time"`
+	HighestBidder sdk.AccAddress `json:"highest_bidder" yaml:"highest_bidder"`
+	HighestBid    sdk.Coins      `json:"highest_bid" yaml:"highest_bid"`
+}
+
+func NewAuction(name string, owner sdk.AccAddress, auctionType string, reservePrice sdk.Coins, startTime time.Time, endTime time.Time, revealEndTime time.Time) Auction {
+	return Auction{
+		Name:          name,
+		Owner:         owner,
+		Type:          auctionType,
+		ReservePrice:  reservePrice,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		RevealEndTime: revealEndTime,
+		HighestBidder: nil,
+		HighestBid:    sdk.NewCoins(),
+	}
+}
+
+// SettlementTime returns the time at which the auction is settled in the EndBlocker.
+// Sealed-bid auctions settle once the reveal period is over.
+func (a Auction) SettlementTime() time.Time {
+	if a.Type == AuctionTypeSealed {
+		return a.RevealEndTime
+	}
+
+	return a.EndTime
+}
+
+func (a Auction) HasBids() bool {
+	return ! a.HighestBidder.Empty()
+}
+
+func (a Auction) String() string {
+	return fmt.Sprintf(`Name: %s
+Owner: %s
+Type: %s
+Reserve price: %s
+Start time: %s
+End time: %s
+Reveal end time: %s
+Highest bidder: %s
+Highest bid: %s`, a.Name, a.Owner, a.Type, a.ReservePrice, a.StartTime, a.EndTime, a.RevealEndTime, a.HighestBidder, a.HighestBid)
+}
+
+type SealedBid struct {
+	Name       string         `json:"name" yaml:"name"`
+	Bidder     sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Commitment []byte         `json:"commitment" yaml:"commitment"`
+	Deposit    sdk.Coins      `json:"deposit" yaml:"deposit"`
+	Revealed   bool           `json:"revealed" yaml:"revealed"`
+	Amount     sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+func NewSealedBid(name string, bidder sdk.AccAddress, commitment []byte, deposit sdk.Coins) SealedBid {
+	return SealedBid{
+		Name:       name,
+		Bidder:     bidder,
+		Commitment: commitment,
+		Deposit:    deposit,
+		Revealed:   false,
+		Amount:     sdk.NewCoins(),
+	}
+}
+
+func (b SealedBid) String() string {
+	return fmt.Sprintf(`Name: %s
+Bidder: %s
+Commitment: %X
+Deposit: %s
+Revealed: %t
+Amount: %s`, b.Name, b.Bidder, b.Commitment, b.Deposit, b.Revealed, b.Amount)
+}
+
+// GetSealedBidCommitment computes the hash a bidder commits to during the bidding period of a sealed-bid auction.
+// The bidder address is part of the preimage so that a commitment cannot be replayed by another account.
+func GetSealedBidCommitment(name string, bidder sdk.AccAddress, amount sdk.Coins, salt string) []byte {
+	hash := sha256.Sum256([]byte(name + Separator + bidder.String() + Separator + amount.String() + Separator + salt))
+
+	return hash[:]
+}
+
+func validateAuctionType(auctionType string) error {
+	if auctionType != AuctionTypeEnglish && auctionType != AuctionTypeSealed {
+		return ErrInvalidAuctionType
+	}
+
+	return nil
+}
@@ -14,6 +14,27 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgRegisterAddress{}, "hra/RegisterAddress", nil)
 	cdc.RegisterConcrete(MsgRemoveAddress{}, "hra/RemoveAddress", nil)
 	cdc.RegisterConcrete(MsgRemoveAllAddresses{}, "hra/RemoveAllAddresses", nil)
+	cdc.RegisterConcrete(MsgOpenAuction{}, "hra/OpenAuction", nil)
+	cdc.RegisterConcrete(MsgPlaceBid{}, "hra/PlaceBid", nil)
+	cdc.RegisterConcrete(MsgCommitBid{}, "hra/CommitBid", nil)
+	cdc.RegisterConcrete(MsgRevealBid{}, "hra/RevealBid", nil)
+	cdc.RegisterConcrete(MsgRegisterSubname{}, "hra/RegisterSubname", nil)
+	cdc.RegisterConcrete(MsgRegisterSubnameAddress{}, "hra/RegisterSubnameAddress", nil)
+	cdc.RegisterConcrete(MsgRemoveSubnameAddress{}, "hra/RemoveSubnameAddress", nil)
+	cdc.RegisterConcrete(MsgSetPrimaryName{}, "hra/SetPrimaryName", nil)
+	cdc.RegisterConcrete(MsgSetTextRecord{}, "hra/SetTextRecord", nil)
+	cdc.RegisterConcrete(MsgRemoveTextRecord{}, "hra/RemoveTextRecord", nil)
+	cdc.RegisterConcrete(MsgApproveOperator{}, "hra/ApproveOperator", nil)
+	cdc.RegisterConcrete(MsgRevokeOperator{}, "hra/RevokeOperator", nil)
+	cdc.RegisterConcrete(MsgOperatorRegisterAddress{}, "hra/OperatorRegisterAddress", nil)
+	cdc.RegisterConcrete(MsgOperatorRemoveAddress{}, "hra/OperatorRemoveAddress", nil)
+	cdc.RegisterConcrete(MsgPlaceOffer{}, "hra/PlaceOffer", nil)
+	cdc.RegisterConcrete(MsgAcceptOffer{}, "hra/AcceptOffer", nil)
+	cdc.RegisterConcrete(MsgWithdrawOffer{}, "hra/WithdrawOffer", nil)
+	cdc.RegisterConcrete(MsgSetAddresses{}, "hra/SetAddresses", nil)
+	cdc.RegisterConcrete(MsgStartTransfer{}, "hra/StartTransfer", nil)
+	cdc.RegisterConcrete(MsgCancelTransfer{}, "hra/CancelTransfer", nil)
+	cdc.RegisterConcrete(MsgRetryFailedQueueItem{}, "hra/RetryFailedQueueItem", nil)
 
 	cdc.RegisterConcrete(RegisterBlockchainIdProposal{}, "hra/RegisterBlockchainIdProposal", nil)
 	cdc.RegisterConcrete(RemoveBlockchainIdProposal{}, "hra/RemoveBlockchainIdProposal", nil)
@@ -19,4 +19,38 @@ var (
 	ErrBlockchainAddressNotFound 	= sdkerrors.Register(ModuleName, 111, "Blockchain Address not found.")
 	ErrNoNamesRegistered         	= sdkerrors.Register(ModuleName, 112, "No names registered.")
 	ErrMaximumDurationExceeded 		= sdkerrors.Register(ModuleName, 113, "Maximum Name Info duration exceeded.")
+	ErrNameInAuction 				= sdkerrors.Register(ModuleName, 114, "Name is currently in auction.")
+	ErrAuctionNotFound 				= sdkerrors.Register(ModuleName, 115, "Auction not found.")
+	ErrInvalidAuctionType 			= sdkerrors.Register(ModuleName, 116, "Invalid auction type.")
+	ErrInvalidAuctionDuration 		= sdkerrors.Register(ModuleName, 117, "Invalid auction duration.")
+	ErrAuctionBiddingClosed 		= sdkerrors.Register(ModuleName, 118, "Auction bidding period is closed.")
+	ErrAuctionRevealClosed 			= sdkerrors.Register(ModuleName, 119, "Auction reveal period is not open.")
+	ErrBidTooLow 					= sdkerrors.Register(ModuleName, 120, "Bid is lower than the reserve price or the highest bid.")
+	ErrSealedBidNotFound 			= sdkerrors.Register(ModuleName, 121, "Sealed bid not found.")
+	ErrSealedBidExists 				= sdkerrors.Register(ModuleName, 122, "Sealed bid already committed.")
+	ErrSealedBidMismatch 			= sdkerrors.Register(ModuleName, 123, "Revealed bid does not match the commitment.")
+	ErrNameExpired 					= sdkerrors.Register(ModuleName, 124, "Name is expired and can only be renewed.")
+	ErrNameInRedemption 			= sdkerrors.Register(ModuleName, 125, "Name is in the redemption period.")
+	ErrSubnameNotAllowed 			= sdkerrors.Register(ModuleName, 126, "Operation is not allowed on subnames.")
+	ErrParentNotRegistered 			= sdkerrors.Register(ModuleName, 127, "Parent name not registered.")
+	ErrPrimaryNameNotSet		 	= sdkerrors.Register(ModuleName, 128, "Primary name not set.")
+	ErrTextRecordKeyNotValid	 	= sdkerrors.Register(ModuleName, 129, "Text record key not valid.")
+	ErrTextRecordValueNotValid	 	= sdkerrors.Register(ModuleName, 130, "Text record value not valid.")
+	ErrTextRecordNotFound		 	= sdkerrors.Register(ModuleName, 131, "Text record not found.")
+	ErrTextRecordLimitExceeded	 	= sdkerrors.Register(ModuleName, 132, "Text record limit exceeded.")
+	ErrAddressValidatorNotValid	 	= sdkerrors.Register(ModuleName, 133, "Address validator not valid.")
+	ErrInvalidOperator			 	= sdkerrors.Register(ModuleName, 134, "Invalid operator.")
+	ErrOperatorNotFound			 	= sdkerrors.Register(ModuleName, 135, "Operator not found.")
+	ErrInvalidPeriods			 	= sdkerrors.Register(ModuleName, 136, "Invalid number of periods.")
+	ErrInvalidPricingSchedule	 	= sdkerrors.Register(ModuleName, 137, "Invalid pricing schedule.")
+	ErrNameReserved			 		= sdkerrors.Register(ModuleName, 138, "Name is reserved.")
+	ErrOfferNotFound			 	= sdkerrors.Register(ModuleName, 139, "Offer not found.")
+	ErrOfferExists			 		= sdkerrors.Register(ModuleName, 140, "Offer already placed, withdraw it first.")
+	ErrInvalidOfferDuration		 	= sdkerrors.Register(ModuleName, 141, "Invalid offer duration.")
+	ErrOfferExpired			 		= sdkerrors.Register(ModuleName, 142, "Offer has expired.")
+	ErrInvalidAddressBatch			= sdkerrors.Register(ModuleName, 143, "Invalid address batch.")
+	ErrInvalidHistoryRetention		= sdkerrors.Register(ModuleName, 144, "Invalid name history retention.")
+	ErrTransferPending				= sdkerrors.Register(ModuleName, 145, "Name has a pending transfer.")
+	ErrTransferNotFound				= sdkerrors.Register(ModuleName, 146, "Pending transfer not found.")
+	ErrFailedQueueItemNotFound		= sdkerrors.Register(ModuleName, 147, "Failed queue item not found.")
 )
@@ -12,9 +12,39 @@ const (
 	EventTypeExpiredName 		= "expired_name"
 	EventTypeRegisterBlockchainId = "RegisterBlockchainId"
 	EventTypeRemoveBlockchainId   = "RemoveBlockchainId"
+	EventTypeSetPricingSchedule   = "SetPricingSchedule"
+	EventTypeSetNameHistoryRetention = "SetNameHistoryRetention"
+	EventTypeReserveNames         = "ReserveNames"
+	EventTypeReleaseNames         = "ReleaseNames"
+	EventTypeRevokeName           = "RevokeName"
+	EventTypeOpenAuction 		= "open_auction"
+	EventTypePlaceBid 			= "place_bid"
+	EventTypeCommitBid 			= "commit_bid"
+	EventTypeRevealBid 			= "reveal_bid"
+	EventTypeRefundBid 			= "refund_bid"
+	EventTypeSettleAuction 		= "settle_auction"
+	EventTypeRegisterSubname 	= "register_subname"
+	EventTypeDeleteSubname 		= "delete_subname"
+	EventTypeSetPrimaryName 	= "set_primary_name"
+	EventTypeClearPrimaryName 	= "clear_primary_name"
+	EventTypeSetTextRecord 		= "set_text_record"
+	EventTypeRemoveTextRecord 	= "remove_text_record"
+	EventTypeApproveOperator 	= "approve_operator"
+	EventTypeRevokeOperator 	= "revoke_operator"
+	EventTypePlaceOffer 		= "place_offer"
+	EventTypeAcceptOffer 		= "accept_offer"
+	EventTypeWithdrawOffer 		= "withdraw_offer"
+	EventTypeExpireOffer 		= "expire_offer"
+	EventTypeSetAddresses 		= "set_addresses"
+	EventTypeStartTransfer 		= "start_transfer"
+	EventTypeCancelTransfer 	= "cancel_transfer"
+	EventTypeCompleteTransfer 	= "complete_transfer"
+	EventTypeEndBlockFailure 	= "end_block_failure"
+	EventTypeRetryFailedQueueItem = "retry_failed_queue_item"
 
 	AttributeKeySender				= "sender"
 	AttributeKeyName  				= "name"
+	AttributeKeyNames  				= "names"
 	AttributeKeyExpires				= "expires"
 	AttributeKeyNewOwner 			= "new_owner"
 	AttributeKeyPrice 				= "price"
@@ -23,6 +53,23 @@ const (
 	AttributeKeyIndex				= "index"
 	AttributeKeyTitle				= "title"
 	AttributeKeyDescription			= "description"
+	AttributeKeyAuctionType 		= "auction_type"
+	AttributeKeyReservePrice 		= "reserve_price"
+	AttributeKeyEndTime 			= "end_time"
+	AttributeKeyBidder 				= "bidder"
+	AttributeKeyAmount 				= "amount"
+	AttributeKeyWinner 				= "winner"
+	AttributeKeyParent 				= "parent"
+	AttributeKeyOwner 				= "owner"
+	AttributeKeyKey 				= "key"
+	AttributeKeyValue 				= "value"
+	AttributeKeyAddressValidator 	= "address_validator"
+	AttributeKeyOperator 			= "operator"
+	AttributeKeyUpserted 			= "upserted"
+	AttributeKeyRemoved 			= "removed"
+	AttributeKeyCompletionTime 		= "completion_time"
+	AttributeKeyAction 				= "action"
+	AttributeKeyReason 				= "reason"
 
 	AttributeValueModule = ModuleName
 )
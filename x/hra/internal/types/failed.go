@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"time"
+)
+
+// FailedQueueItem is an auction, offer, pending transfer or expired name the end blocker failed to process. It is
+// taken out of its queue so that it isn't retried every block, and stays in the failed set until it is retried with
+// MsgRetryFailedQueueItem. The bidder is only set for offers.
+type FailedQueueItem struct {
+	Action   string         `json:"action" yaml:"action"`
+	Name     string         `json:"name" yaml:"name"`
+	Bidder   sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Reason   string         `json:"reason" yaml:"reason"`
+	FailedAt time.Time      `json:"failed_at" yaml:"failed_at"`
+}
+
+func NewFailedQueueItem(action string, name string, bidder sdk.AccAddress, reason string, failedAt time.Time) FailedQueueItem {
+	return FailedQueueItem{
+		Action:   action,
+		Name:     name,
+		Bidder:   bidder,
+		Reason:   reason,
+		FailedAt: failedAt,
+	}
+}
+
+func (f FailedQueueItem) String() string {
+	return fmt.Sprintf(`Action: %s
+Name: %s
+Bidder: %s
+Reason: %s
+Failed at: %s`, f.Action, f.Name, f.Bidder, f.Reason, f.FailedAt)
+}
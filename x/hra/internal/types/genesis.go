@@ -8,18 +8,40 @@ type GenesisState struct {
 	Params 					Params 		`json:"params" yaml:"params"`
 	NameRecords 			[]NameInfo 	`json:"name_records" yaml:"name_records"`
 	AddressRecords			[]BlockchainAddressRecordInfo `json:"address_records" yaml:"address_records"`
+	NameAddressRecords	[]NameAddressRecordInfo `json:"name_address_records" yaml:"name_address_records"`
 	AddressCredits          []AddressCreditsInfo    `json:"address_credits" yaml:"address_credits"`
 	RegisteredBlockchainIds []string	`json:"registered_blockchain_ids" yaml:"registered_blockchain_ids"`
+	Auctions 				[]Auction 	`json:"auctions" yaml:"auctions"`
+	SealedBids 				[]SealedBid `json:"sealed_bids" yaml:"sealed_bids"`
+	PrimaryNames 			[]PrimaryNameInfo `json:"primary_names" yaml:"primary_names"`
+	TextRecords 			[]TextRecord `json:"text_records" yaml:"text_records"`
+	AddressValidators 		[]BlockchainIdAddressValidatorInfo `json:"address_validators" yaml:"address_validators"`
+	Operators 				[]OperatorInfo `json:"operators" yaml:"operators"`
+	ReservedNames 			[]string 	`json:"reserved_names" yaml:"reserved_names"`
+	Offers 					[]Offer 	`json:"offers" yaml:"offers"`
+	NameHistory 			[]NameHistoryEntry `json:"name_history" yaml:"name_history"`
+	PendingTransfers 		[]PendingTransfer `json:"pending_transfers" yaml:"pending_transfers"`
 }
 
 
-func NewGenesisState(params Params, nameRecords []NameInfo, addressRecords []BlockchainAddressRecordInfo, addressCredits []AddressCreditsInfo, registeredBlockchainIds []string) GenesisState {
+func NewGenesisState(params Params, nameRecords []NameInfo, addressRecords []BlockchainAddressRecordInfo, nameAddressRecords []NameAddressRecordInfo, addressCredits []AddressCreditsInfo, registeredBlockchainIds []string, auctions []Auction, sealedBids []SealedBid, primaryNames []PrimaryNameInfo, textRecords []TextRecord, addressValidators []BlockchainIdAddressValidatorInfo, operators []OperatorInfo, reservedNames []string, offers []Offer, nameHistory []NameHistoryEntry, pendingTransfers []PendingTransfer) GenesisState {
 	return GenesisState{
 		Params: params,
 		NameRecords: nameRecords,
 		AddressRecords: addressRecords,
+		NameAddressRecords: nameAddressRecords,
 		AddressCredits: addressCredits,
 		RegisteredBlockchainIds: registeredBlockchainIds,
+		Auctions: auctions,
+		SealedBids: sealedBids,
+		PrimaryNames: primaryNames,
+		TextRecords: textRecords,
+		AddressValidators: addressValidators,
+		Operators: operators,
+		ReservedNames: reservedNames,
+		Offers: offers,
+		NameHistory: nameHistory,
+		PendingTransfers: pendingTransfers,
 	}
 }
 
@@ -28,8 +50,19 @@ func DefaultGenesisState() GenesisState {
 		Params: DefaultParams(),
 		NameRecords: []NameInfo{},
 		AddressRecords: []BlockchainAddressRecordInfo{},
+		NameAddressRecords: []NameAddressRecordInfo{},
 		AddressCredits: []AddressCreditsInfo{},
 		RegisteredBlockchainIds: DefaultRegisteredBlockchainIds,
+		Auctions: []Auction{},
+		SealedBids: []SealedBid{},
+		PrimaryNames: []PrimaryNameInfo{},
+		TextRecords: []TextRecord{},
+		AddressValidators: []BlockchainIdAddressValidatorInfo{},
+		Operators: []OperatorInfo{},
+		ReservedNames: DefaultReservedNames,
+		Offers: []Offer{},
+		NameHistory: []NameHistoryEntry{},
+		PendingTransfers: []PendingTransfer{},
 	}
 }
 
@@ -46,6 +79,12 @@ func ValidateGenesis(data GenesisState) error {
 		if err != nil {
 			return sdkerrors.Wrapf(err, "invalid NameRecord: Name: %s. Error: Missing name", record.Name)
 		}
+		if record.IsSubname() {
+			_, parent := SplitSubname(record.Name)
+			if parent != record.Parent {
+				return sdkerrors.Wrapf(ErrNameNotValid, "invalid NameRecord: Name: %s. Error: Parent mismatch", record.Name)
+			}
+		}
 	}
 	for _, record := range data.AddressRecords {
 		if record.Address.Empty() {
@@ -66,6 +105,27 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+	for _, record := range data.NameAddressRecords {
+		err := validateName(record.Name)
+		if err != nil {
+			return err
+		}
+
+		err = validateBlockchainId(record.BlockchainAddressInfo.BlockchainId)
+		if err != nil {
+			return err
+		}
+
+		err = validateIndex(record.BlockchainAddressInfo.Index)
+		if err != nil {
+			return err
+		}
+
+		err = validateBlockchainAddress(record.BlockchainAddressInfo.BlockchainAddress)
+		if err != nil {
+			return err
+		}
+	}
 	for _, record := range data.AddressCredits {
 		if record.Address.Empty() {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, record.Address.String())
@@ -77,5 +137,105 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+	for _, record := range data.Auctions {
+		if record.Owner.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, record.Owner.String())
+		}
+		err := validateName(record.Name)
+		if err != nil {
+			return err
+		}
+		err = validateAuctionType(record.Type)
+		if err != nil {
+			return err
+		}
+	}
+	for _, record := range data.SealedBids {
+		if record.Bidder.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, record.Bidder.String())
+		}
+		err := validateName(record.Name)
+		if err != nil {
+			return err
+		}
+	}
+	for _, record := range data.PrimaryNames {
+		if record.Address.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, record.Address.String())
+		}
+		err := validateName(record.Name)
+		if err != nil {
+			return err
+		}
+	}
+	for _, record := range data.TextRecords {
+		err := validateName(record.Name)
+		if err != nil {
+			return err
+		}
+		err = validateTextRecordKey(record.Key)
+		if err != nil {
+			return err
+		}
+		err = validateTextRecordValue(record.Value)
+		if err != nil {
+			return err
+		}
+	}
+	for _, record := range data.AddressValidators {
+		err := validateBlockchainId(record.BlockchainId)
+		if err != nil {
+			return err
+		}
+		err = validateAddressValidator(record.AddressValidator)
+		if err != nil {
+			return err
+		}
+	}
+	for _, record := range data.Operators {
+		if record.Operator.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, record.Operator.String())
+		}
+		err := validateName(record.Name)
+		if err != nil {
+			return err
+		}
+	}
+	for _, record := range data.ReservedNames {
+		err := ValidateTopLevelName(record)
+		if err != nil {
+			return err
+		}
+	}
+	for _, record := range data.Offers {
+		if record.Bidder.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, record.Bidder.String())
+		}
+		err := validateName(record.Name)
+		if err != nil {
+			return err
+		}
+		if ! record.Amount.IsValid() || record.Amount.IsZero() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, record.Amount.String())
+		}
+	}
+	for _, record := range data.NameHistory {
+		err := validateName(record.Name)
+		if err != nil {
+			return err
+		}
+	}
+	for _, record := range data.PendingTransfers {
+		if record.Owner.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, record.Owner.String())
+		}
+		if record.NewOwner.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, record.NewOwner.String())
+		}
+		err := validateName(record.Name)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
\ No newline at end of file
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"time"
+)
+
+const (
+	HistoryEventRegister 	= "register"
+	HistoryEventRenew 		= "renew"
+	HistoryEventSetPrice 	= "set_price"
+	HistoryEventTransfer 	= "transfer"
+	HistoryEventSale 		= "sale"
+	HistoryEventDelete 		= "delete"
+	HistoryEventExpire 		= "expire"
+	HistoryEventRevoke 		= "revoke"
+)
+
+// NameHistoryEntry records a change of a name. Owner is the owner after the change, PreviousOwner is only set for
+// transfers and sales, and Price holds the sale price or the newly set price.
+type NameHistoryEntry struct {
+	Name 			string 			`json:"name" yaml:"name"`
+	Sequence 		uint64 			`json:"sequence" yaml:"sequence"`
+	Event 			string 			`json:"event" yaml:"event"`
+	Owner 			sdk.AccAddress 	`json:"owner" yaml:"owner"`
+	PreviousOwner 	sdk.AccAddress 	`json:"previous_owner" yaml:"previous_owner"`
+	Price 			sdk.Coins 		`json:"price" yaml:"price"`
+	ExpiryTime 		time.Time 		`json:"expiry_time" yaml:"expiry_time"`
+	Height 			int64 			`json:"height" yaml:"height"`
+	Time 			time.Time 		`json:"time" yaml:"time"`
+}
+
+func NewNameHistoryEntry(name string, sequence uint64, event string, owner sdk.AccAddress, previousOwner sdk.AccAddress, price sdk.Coins, expiryTime time.Time, height int64, time time.Time) NameHistoryEntry {
+	return NameHistoryEntry{
+		Name: name,
+		Sequence: sequence,
+		Event: event,
+		Owner: owner,
+		PreviousOwner: previousOwner,
+		Price: price,
+		ExpiryTime: expiryTime,
+		Height: height,
+		Time: time,
+	}
+}
+
+func (e NameHistoryEntry) String() string {
+	return fmt.Sprintf(`Name: %s
+Sequence: %d
+Event: %s
+Owner: %s
+Previous owner: %s
+Price: %s
+Expiry time: %s
+Height: %d
+Time: %s`, e.Name, e.Sequence, e.Event, e.Owner, e.PreviousOwner, e.Price, e.ExpiryTime, e.Height, e.Time)
+}
@@ -15,6 +15,9 @@ const (
 
 	QuerierRoute = ModuleName
 
+	AuctionEscrowModuleName = "hra_auction" // Module stores the funds escrowed by auction bidders
+	OfferEscrowModuleName = "hra_offer" // Module stores the funds escrowed by open offers
+
 	Separator = ":"
 )
 
@@ -27,6 +30,25 @@ const (
 // - 0x13<Addr_Bytes><Separator><BlockchainId_Bytes><Separator><AddressIndex_Bytes>: BlockchainAddress
 // - 0x14<Addr_Bytes>: Int
 // - 0x15<BlockchainId_Bytes>: boolean
+// - 0x16<Name_Bytes>: Auction
+// - 0x17<endTime_Bytes><Name_Bytes>: Name
+// - 0x18<Name_Bytes><Separator><Addr_Bytes>: SealedBid
+// - 0x19<ParentName_Bytes><Separator><Name_Bytes>: boolean
+// - 0x1A<Name_Bytes><Separator><BlockchainId_Bytes><Separator><AddressIndex_Bytes>: BlockchainAddress
+// - 0x1B<Addr_Bytes>: Name
+// - 0x1C<Name_Bytes><Separator><Key_Bytes>: Value
+// - 0x1D<BlockchainId_Bytes>: AddressValidator
+// - 0x1E<Name_Bytes><Separator><Addr_Bytes>: boolean
+// - 0x1F<Name_Bytes>: boolean
+// - 0x20<Name_Bytes><Separator><Addr_Bytes>: Offer
+// - 0x21<endTime_Bytes><Name_Bytes><Separator><Addr_Bytes>: Name
+// - 0x22<Addr_Bytes><Separator><Name_Bytes>: boolean
+// - 0x23<Name_Bytes><Separator><Sequence_Bytes>: NameHistoryEntry
+// - 0x24<Name_Bytes>: next history sequence
+// - 0x25<Name_Bytes>: PendingTransfer
+// - 0x26<completionTime_Bytes><Name_Bytes>: Name
+// - 0x27<Name_Bytes>: boolean
+// - 0x28<Action_Bytes><Separator><Name_Bytes><Separator><Addr_Bytes>: FailedQueueItem
 var (
 	NameInfoByNameKeyPrefix         = []byte{0x10}
 	StatusByAddressAndNameKeyPrefix = []byte{0x11}
@@ -34,6 +56,25 @@ var (
 	AddressKeyPrefix                = []byte{0x13}
 	CreditsKeyPrefix                = []byte{0x14}
 	RegisteredBlockchainIdKeyPrefix = []byte{0x15}
+	AuctionKeyPrefix                = []byte{0x16}
+	AuctionQueueKeyPrefix           = []byte{0x17}
+	SealedBidKeyPrefix              = []byte{0x18}
+	SubnameKeyPrefix                = []byte{0x19}
+	NameAddressKeyPrefix            = []byte{0x1A}
+	PrimaryNameKeyPrefix            = []byte{0x1B}
+	TextRecordKeyPrefix             = []byte{0x1C}
+	AddressValidatorKeyPrefix       = []byte{0x1D}
+	OperatorKeyPrefix               = []byte{0x1E}
+	ReservedNameKeyPrefix           = []byte{0x1F}
+	OfferKeyPrefix                  = []byte{0x20}
+	OfferQueueKeyPrefix             = []byte{0x21}
+	BidderOfferKeyPrefix            = []byte{0x22}
+	NameHistoryKeyPrefix            = []byte{0x23}
+	NameHistorySequenceKeyPrefix    = []byte{0x24}
+	PendingTransferKeyPrefix        = []byte{0x25}
+	PendingTransferQueueKeyPrefix   = []byte{0x26}
+	NameForSaleKeyPrefix            = []byte{0x27}
+	FailedQueueItemKeyPrefix        = []byte{0x28}
 
 	StatusPresent = []byte{0x01}
 	StatusAbsent = []byte{0x00}
@@ -45,6 +86,10 @@ func GetNameInfoByNameKey(name string) []byte {
 	return append(NameInfoByNameKeyPrefix, []byte(name)...)
 }
 
+func SplitNameInfoByNameKey(key []byte) string {
+	return string(key[1:])
+}
+
 func GetStatusByAddressAndNameKey(address sdk.AccAddress, name string) []byte {
 	key := append(StatusByAddressAndNameKeyPrefix, address...)
 	key = append(key, []byte(Separator)...)
@@ -114,6 +159,69 @@ func GetRegisteredBlockchainIdIteratorKey() []byte {
 func SplitRegisteredBlockchainIdKey(key []byte) (string) {
 	return string(key[1:])
 }
+// Auction
+func GetAuctionKey(name string) []byte {
+	return append(AuctionKeyPrefix, []byte(name)...)
+}
+
+func AuctionByTimeKey(endTime time.Time) []byte {
+	return append(AuctionQueueKeyPrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+func AuctionQueueKey(name string, endTime time.Time) []byte {
+	return append(AuctionByTimeKey(endTime), []byte(name)...)
+}
+
+func SplitAuctionQueueKey(key []byte) (name string, endTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+func GetSealedBidIteratorKey(name string) []byte {
+	key := append(SealedBidKeyPrefix, []byte(name)...)
+	return append(key, []byte(Separator)...)
+}
+
+func GetSealedBidKey(name string, bidder sdk.AccAddress) []byte {
+	return append(GetSealedBidIteratorKey(name), bidder...)
+}
+
+// Subname
+func GetSubnameIteratorKey(parent string) []byte {
+	key := append(SubnameKeyPrefix, []byte(parent)...)
+	return append(key, []byte(Separator)...)
+}
+
+func GetSubnameKey(parent string, name string) []byte {
+	return append(GetSubnameIteratorKey(parent), []byte(name)...)
+}
+
+func SplitSubnameKey(key []byte) (string) {
+	parts := strings.SplitN(string(key[1:]), Separator, 2)
+
+	return parts[1]
+}
+
+// Name Address
+func GetNameAddressIteratorKey(name string) []byte {
+	key := append(NameAddressKeyPrefix, []byte(name)...)
+	return append(key, []byte(Separator)...)
+}
+
+func GetNameAddressKey(name string, blockchainId string, index string) []byte {
+	// Craft KVStore key in format: Name:BlockchainId:AddressIndex
+	key := append(GetNameAddressIteratorKey(name), []byte(blockchainId)...)
+	key = append(key, []byte(Separator)...)
+	key = append(key, []byte(index)...)
+
+	return key
+}
+
+func SplitNameAddressKey(key []byte) (nameAddressRecord NameAddressRecordInfo) {
+	parts := strings.Split(string(key[1:]), Separator)
+
+	return NewNameAddressRecordInfo(parts[0], NewBlockchainAddressInfo(parts[1], parts[2], ""))
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (name string, endTime time.Time) {
@@ -130,4 +238,142 @@ func splitKeyWithTime(key []byte) (name string, endTime time.Time) {
 
 	name = string(key[1 + lenTime:])
 	return
-}
\ No newline at end of file
+}
+// Primary name
+func GetPrimaryNameKey(address sdk.AccAddress) []byte {
+	return append(PrimaryNameKeyPrefix, address.Bytes()...)
+}
+
+// Text record
+func GetTextRecordIteratorKey(name string) []byte {
+	key := append(TextRecordKeyPrefix, []byte(name)...)
+	return append(key, []byte(Separator)...)
+}
+
+func GetTextRecordKey(name string, key string) []byte {
+	return append(GetTextRecordIteratorKey(name), []byte(key)...)
+}
+
+func SplitTextRecordKey(key []byte) (name string, recordKey string) {
+	parts := strings.SplitN(string(key[1:]), Separator, 2)
+
+	return parts[0], parts[1]
+}
+
+// Address validator
+func GetAddressValidatorKey(blockchainId string) []byte {
+	return append(AddressValidatorKeyPrefix, []byte(blockchainId)...)
+}
+
+// Operator
+func GetOperatorIteratorKey(name string) []byte {
+	key := append(OperatorKeyPrefix, []byte(name)...)
+	return append(key, []byte(Separator)...)
+}
+
+func GetOperatorKey(name string, operator sdk.AccAddress) []byte {
+	return append(GetOperatorIteratorKey(name), operator.Bytes()...)
+}
+
+func SplitOperatorKey(name string, key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[len(GetOperatorIteratorKey(name)):])
+}
+
+// Reserved name
+func GetReservedNameKey(name string) []byte {
+	return append(ReservedNameKeyPrefix, []byte(name)...)
+}
+
+func SplitReservedNameKey(key []byte) string {
+	return string(key[1:])
+}
+
+// Names for sale
+func GetNameForSaleKey(name string) []byte {
+	return append(NameForSaleKeyPrefix, []byte(name)...)
+}
+
+func SplitNameForSaleKey(key []byte) string {
+	return string(key[1:])
+}
+
+// Offer
+func GetOfferIteratorKey(name string) []byte {
+	key := append(OfferKeyPrefix, []byte(name)...)
+	return append(key, []byte(Separator)...)
+}
+
+func GetOfferKey(name string, bidder sdk.AccAddress) []byte {
+	return append(GetOfferIteratorKey(name), bidder...)
+}
+
+func OfferByTimeKey(endTime time.Time) []byte {
+	return append(OfferQueueKeyPrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+func OfferQueueKey(name string, bidder sdk.AccAddress, endTime time.Time) []byte {
+	key := append(OfferByTimeKey(endTime), []byte(name)...)
+	key = append(key, []byte(Separator)...)
+	return append(key, bidder...)
+}
+
+func SplitOfferQueueKey(key []byte) (name string, bidder sdk.AccAddress, endTime time.Time) {
+	rest, endTime := splitKeyWithTime(key)
+
+	parts := strings.SplitN(rest, Separator, 2)
+
+	return parts[0], sdk.AccAddress(parts[1]), endTime
+}
+
+func GetBidderOfferIteratorKey(bidder sdk.AccAddress) []byte {
+	key := append(BidderOfferKeyPrefix, bidder...)
+	return append(key, []byte(Separator)...)
+}
+
+func GetBidderOfferKey(bidder sdk.AccAddress, name string) []byte {
+	return append(GetBidderOfferIteratorKey(bidder), []byte(name)...)
+}
+
+func SplitBidderOfferKey(bidder sdk.AccAddress, key []byte) string {
+	return string(key[len(GetBidderOfferIteratorKey(bidder)):])
+}
+
+// Name history
+func GetNameHistoryIteratorKey(name string) []byte {
+	key := append(NameHistoryKeyPrefix, []byte(name)...)
+	return append(key, []byte(Separator)...)
+}
+
+func GetNameHistoryKey(name string, sequence uint64) []byte {
+	return append(GetNameHistoryIteratorKey(name), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+func GetNameHistorySequenceKey(name string) []byte {
+	return append(NameHistorySequenceKeyPrefix, []byte(name)...)
+}
+
+// Pending transfer
+func GetPendingTransferKey(name string) []byte {
+	return append(PendingTransferKeyPrefix, []byte(name)...)
+}
+
+func PendingTransferByTimeKey(completionTime time.Time) []byte {
+	return append(PendingTransferQueueKeyPrefix, sdk.FormatTimeBytes(completionTime)...)
+}
+
+func PendingTransferQueueKey(name string, completionTime time.Time) []byte {
+	return append(PendingTransferByTimeKey(completionTime), []byte(name)...)
+}
+
+func SplitPendingTransferQueueKey(key []byte) (name string, completionTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+// Failed queue item, the bidder is only set for offers
+func GetFailedQueueItemKey(action string, name string, bidder sdk.AccAddress) []byte {
+	key := append(FailedQueueItemKeyPrefix, []byte(action)...)
+	key = append(key, []byte(Separator)...)
+	key = append(key, []byte(name)...)
+	key = append(key, []byte(Separator)...)
+	return append(key, bidder...)
+}
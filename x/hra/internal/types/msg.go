@@ -1,21 +1,25 @@
 package types
 
 import (
+	"crypto/sha256"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/anathatech/project-anatha/config"
+	"time"
 )
 
 // MsgRegisterName
 type MsgRegisterName struct {
-	Name  string         `json:"name" yaml:"name"`
-	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	Name    string         `json:"name" yaml:"name"`
+	Owner   sdk.AccAddress `json:"owner" yaml:"owner"`
+	Periods uint64         `json:"periods" yaml:"periods"`
 }
 
-func NewMsgRegisterName(name string, owner sdk.AccAddress) MsgRegisterName {
+func NewMsgRegisterName(name string, owner sdk.AccAddress, periods uint64) MsgRegisterName {
 	return MsgRegisterName{
-		Name:  name,
-		Owner: owner,
+		Name:    name,
+		Owner:   owner,
+		Periods: periods,
 	}
 }
 
@@ -24,17 +28,25 @@ func (msg MsgRegisterName) Route() string { return RouterKey }
 func (msg MsgRegisterName) Type() string { return "register_name" }
 
 func (msg MsgRegisterName) ValidateBasic() error {
-	err := validateName(msg.Name)
+	err := ValidateTopLevelName(msg.Name)
 	if err != nil {
 		return err
 	}
 	if msg.Owner.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
 	}
+	if msg.Periods > MaxPeriods {
+		return sdkerrors.Wrapf(ErrInvalidPeriods, "at most %d periods can be bought at once", MaxPeriods)
+	}
 
 	return nil
 }
 
+// GetPeriods treats a message without periods as a registration for a single period
+func (msg MsgRegisterName) GetPeriods() uint64 {
+	return periodsOrDefault(msg.Periods)
+}
+
 func (msg MsgRegisterName) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
@@ -45,14 +57,16 @@ func (msg MsgRegisterName) GetSigners() []sdk.AccAddress {
 
 // MsgRenewName
 type MsgRenewName struct {
-	Name  string         `json:"name" yaml:"name"`
-	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	Name    string         `json:"name" yaml:"name"`
+	Owner   sdk.AccAddress `json:"owner" yaml:"owner"`
+	Periods uint64         `json:"periods" yaml:"periods"`
 }
 
-func NewMsgRenewName(name string, owner sdk.AccAddress) MsgRenewName {
+func NewMsgRenewName(name string, owner sdk.AccAddress, periods uint64) MsgRenewName {
 	return MsgRenewName{
-		Name:  name,
-		Owner: owner,
+		Name:    name,
+		Owner:   owner,
+		Periods: periods,
 	}
 }
 
@@ -68,9 +82,17 @@ func (msg MsgRenewName) ValidateBasic() error {
 	if msg.Owner.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
 	}
+	if msg.Periods > MaxPeriods {
+		return sdkerrors.Wrapf(ErrInvalidPeriods, "at most %d periods can be bought at once", MaxPeriods)
+	}
 	return nil
 }
 
+// GetPeriods treats a message without periods as a renewal for a single period
+func (msg MsgRenewName) GetPeriods() uint64 {
+	return periodsOrDefault(msg.Periods)
+}
+
 func (msg MsgRenewName) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
@@ -362,4 +384,949 @@ func (msg MsgRemoveAllAddresses) GetSignBytes() []byte {
 
 func (msg MsgRemoveAllAddresses) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
-}
\ No newline at end of file
+}
+
+// MsgOpenAuction
+type MsgOpenAuction struct {
+	Name  			string         	`json:"name" yaml:"name"`
+	Owner 			sdk.AccAddress 	`json:"owner" yaml:"owner"`
+	AuctionType 	string 			`json:"auction_type" yaml:"auction_type"`
+	ReservePrice 	sdk.Coins		`json:"reserve_price" yaml:"reserve_price"`
+	Duration 		time.Duration 	`json:"duration" yaml:"duration"`
+}
+
+func NewMsgOpenAuction(name string, owner sdk.AccAddress, auctionType string, reservePrice sdk.Coins, duration time.Duration) MsgOpenAuction {
+	return MsgOpenAuction{
+		Name:  name,
+		Owner: owner,
+		AuctionType: auctionType,
+		ReservePrice: reservePrice,
+		Duration: duration,
+	}
+}
+
+func (msg MsgOpenAuction) Route() string { return RouterKey }
+
+func (msg MsgOpenAuction) Type() string { return "open_auction" }
+
+func (msg MsgOpenAuction) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	err = validateAuctionType(msg.AuctionType)
+	if err != nil {
+		return err
+	}
+	if msg.ReservePrice.AmountOf(config.DefaultDenom).IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid denomination.")
+	}
+	if msg.Duration <= 0 {
+		return ErrInvalidAuctionDuration
+	}
+	return nil
+}
+
+func (msg MsgOpenAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgOpenAuction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgPlaceBid
+type MsgPlaceBid struct {
+	Name  	string         	`json:"name" yaml:"name"`
+	Bidder 	sdk.AccAddress 	`json:"bidder" yaml:"bidder"`
+	Amount 	sdk.Coins		`json:"amount" yaml:"amount"`
+}
+
+func NewMsgPlaceBid(name string, bidder sdk.AccAddress, amount sdk.Coins) MsgPlaceBid {
+	return MsgPlaceBid{
+		Name:  name,
+		Bidder: bidder,
+		Amount: amount,
+	}
+}
+
+func (msg MsgPlaceBid) Route() string { return RouterKey }
+
+func (msg MsgPlaceBid) Type() string { return "place_bid" }
+
+func (msg MsgPlaceBid) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Bidder.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Bidder.String())
+	}
+	if msg.Amount.AmountOf(config.DefaultDenom).IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid denomination.")
+	}
+	return nil
+}
+
+func (msg MsgPlaceBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgPlaceBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgCommitBid
+type MsgCommitBid struct {
+	Name  		string         	`json:"name" yaml:"name"`
+	Bidder 		sdk.AccAddress 	`json:"bidder" yaml:"bidder"`
+	Commitment 	[]byte 			`json:"commitment" yaml:"commitment"`
+	Deposit 	sdk.Coins		`json:"deposit" yaml:"deposit"`
+}
+
+func NewMsgCommitBid(name string, bidder sdk.AccAddress, commitment []byte, deposit sdk.Coins) MsgCommitBid {
+	return MsgCommitBid{
+		Name:  name,
+		Bidder: bidder,
+		Commitment: commitment,
+		Deposit: deposit,
+	}
+}
+
+func (msg MsgCommitBid) Route() string { return RouterKey }
+
+func (msg MsgCommitBid) Type() string { return "commit_bid" }
+
+func (msg MsgCommitBid) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Bidder.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Bidder.String())
+	}
+	if len(msg.Commitment) != sha256.Size {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid commitment length.")
+	}
+	if msg.Deposit.AmountOf(config.DefaultDenom).IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid denomination.")
+	}
+	return nil
+}
+
+func (msg MsgCommitBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCommitBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgRevealBid
+type MsgRevealBid struct {
+	Name  	string         	`json:"name" yaml:"name"`
+	Bidder 	sdk.AccAddress 	`json:"bidder" yaml:"bidder"`
+	Amount 	sdk.Coins		`json:"amount" yaml:"amount"`
+	Salt 	string 			`json:"salt" yaml:"salt"`
+}
+
+func NewMsgRevealBid(name string, bidder sdk.AccAddress, amount sdk.Coins, salt string) MsgRevealBid {
+	return MsgRevealBid{
+		Name:  name,
+		Bidder: bidder,
+		Amount: amount,
+		Salt: salt,
+	}
+}
+
+func (msg MsgRevealBid) Route() string { return RouterKey }
+
+func (msg MsgRevealBid) Type() string { return "reveal_bid" }
+
+func (msg MsgRevealBid) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Bidder.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Bidder.String())
+	}
+	if msg.Amount.AmountOf(config.DefaultDenom).IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid denomination.")
+	}
+	return nil
+}
+
+func (msg MsgRevealBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRevealBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+
+// MsgRegisterSubname
+type MsgRegisterSubname struct {
+	Name  			string         	`json:"name" yaml:"name"`
+	Owner 			sdk.AccAddress 	`json:"owner" yaml:"owner"`
+	SubnameOwner 	sdk.AccAddress	`json:"subname_owner" yaml:"subname_owner"`
+}
+
+func NewMsgRegisterSubname(name string, owner sdk.AccAddress, subnameOwner sdk.AccAddress) MsgRegisterSubname {
+	return MsgRegisterSubname{
+		Name:  name,
+		Owner: owner,
+		SubnameOwner: subnameOwner,
+	}
+}
+
+func (msg MsgRegisterSubname) Route() string { return RouterKey }
+
+func (msg MsgRegisterSubname) Type() string { return "register_subname" }
+
+func (msg MsgRegisterSubname) ValidateBasic() error {
+	err := validateSubname(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if msg.SubnameOwner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.SubnameOwner.String())
+	}
+	return nil
+}
+
+func (msg MsgRegisterSubname) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRegisterSubname) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRegisterSubnameAddress
+type MsgRegisterSubnameAddress struct {
+	Name  				string         	`json:"name" yaml:"name"`
+	Owner 				sdk.AccAddress 	`json:"owner" yaml:"owner"`
+	BlockchainId 		string			`json:"blockchain_id" yaml:"blockchain_id"`
+	Index 				string			`json:"index" yaml:"index"`
+	BlockchainAddress 	string			`json:"blockchain_address" yaml:"blockchain_address"`
+}
+
+func NewMsgRegisterSubnameAddress(name string, owner sdk.AccAddress, blockchainId string, index string, blockchainAddress string) MsgRegisterSubnameAddress {
+	return MsgRegisterSubnameAddress{
+		Name: name,
+		Owner: owner,
+		BlockchainId: blockchainId,
+		Index: index,
+		BlockchainAddress: blockchainAddress,
+	}
+}
+
+func (msg MsgRegisterSubnameAddress) Route() string { return RouterKey }
+
+func (msg MsgRegisterSubnameAddress) Type() string { return "register_subname_address" }
+
+func (msg MsgRegisterSubnameAddress) ValidateBasic() error {
+	err := validateSubname(msg.Name)
+	if err != nil {
+		return err
+	}
+
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	err = validateBlockchainId(msg.BlockchainId)
+	if err != nil {
+		return err
+	}
+
+	err = validateIndex(msg.Index)
+	if err != nil {
+		return err
+	}
+
+	err = validateBlockchainAddress(msg.BlockchainAddress)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (msg MsgRegisterSubnameAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRegisterSubnameAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRemoveSubnameAddress
+type MsgRemoveSubnameAddress struct {
+	Name  			string         	`json:"name" yaml:"name"`
+	Owner 			sdk.AccAddress 	`json:"owner" yaml:"owner"`
+	BlockchainId 	string			`json:"blockchain_id" yaml:"blockchain_id"`
+	Index 			string			`json:"index" yaml:"index"`
+}
+
+func NewMsgRemoveSubnameAddress(name string, owner sdk.AccAddress, blockchainId string, index string) MsgRemoveSubnameAddress {
+	return MsgRemoveSubnameAddress{
+		Name: name,
+		Owner: owner,
+		BlockchainId: blockchainId,
+		Index: index,
+	}
+}
+
+func (msg MsgRemoveSubnameAddress) Route() string { return RouterKey }
+
+func (msg MsgRemoveSubnameAddress) Type() string { return "remove_subname_address" }
+
+func (msg MsgRemoveSubnameAddress) ValidateBasic() error {
+	err := validateSubname(msg.Name)
+	if err != nil {
+		return err
+	}
+
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	err = validateBlockchainId(msg.BlockchainId)
+	if err != nil {
+		return err
+	}
+
+	err = validateIndex(msg.Index)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (msg MsgRemoveSubnameAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRemoveSubnameAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+// MsgSetPrimaryName
+type MsgSetPrimaryName struct {
+	Name  string         `json:"name" yaml:"name"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+func NewMsgSetPrimaryName(name string, owner sdk.AccAddress) MsgSetPrimaryName {
+	return MsgSetPrimaryName{
+		Name:  name,
+		Owner: owner,
+	}
+}
+
+func (msg MsgSetPrimaryName) Route() string { return RouterKey }
+
+func (msg MsgSetPrimaryName) Type() string { return "set_primary_name" }
+
+func (msg MsgSetPrimaryName) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	return nil
+}
+
+func (msg MsgSetPrimaryName) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetPrimaryName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgSetTextRecord
+type MsgSetTextRecord struct {
+	Name  string         `json:"name" yaml:"name"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	Key   string         `json:"key" yaml:"key"`
+	Value string         `json:"value" yaml:"value"`
+}
+
+func NewMsgSetTextRecord(name string, owner sdk.AccAddress, key string, value string) MsgSetTextRecord {
+	return MsgSetTextRecord{
+		Name:  name,
+		Owner: owner,
+		Key:   key,
+		Value: value,
+	}
+}
+
+func (msg MsgSetTextRecord) Route() string { return RouterKey }
+
+func (msg MsgSetTextRecord) Type() string { return "set_text_record" }
+
+func (msg MsgSetTextRecord) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	err = validateTextRecordKey(msg.Key)
+	if err != nil {
+		return err
+	}
+	return validateTextRecordValue(msg.Value)
+}
+
+func (msg MsgSetTextRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetTextRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRemoveTextRecord
+type MsgRemoveTextRecord struct {
+	Name  string         `json:"name" yaml:"name"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	Key   string         `json:"key" yaml:"key"`
+}
+
+func NewMsgRemoveTextRecord(name string, owner sdk.AccAddress, key string) MsgRemoveTextRecord {
+	return MsgRemoveTextRecord{
+		Name:  name,
+		Owner: owner,
+		Key:   key,
+	}
+}
+
+func (msg MsgRemoveTextRecord) Route() string { return RouterKey }
+
+func (msg MsgRemoveTextRecord) Type() string { return "remove_text_record" }
+
+func (msg MsgRemoveTextRecord) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	return validateTextRecordKey(msg.Key)
+}
+
+func (msg MsgRemoveTextRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRemoveTextRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgApproveOperator
+type MsgApproveOperator struct {
+	Name     string         `json:"name" yaml:"name"`
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Operator sdk.AccAddress `json:"operator" yaml:"operator"`
+}
+
+func NewMsgApproveOperator(name string, owner sdk.AccAddress, operator sdk.AccAddress) MsgApproveOperator {
+	return MsgApproveOperator{
+		Name:     name,
+		Owner:    owner,
+		Operator: operator,
+	}
+}
+
+func (msg MsgApproveOperator) Route() string { return RouterKey }
+
+func (msg MsgApproveOperator) Type() string { return "approve_operator" }
+
+func (msg MsgApproveOperator) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if msg.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Operator.String())
+	}
+	return nil
+}
+
+func (msg MsgApproveOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgApproveOperator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRevokeOperator
+type MsgRevokeOperator struct {
+	Name     string         `json:"name" yaml:"name"`
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Operator sdk.AccAddress `json:"operator" yaml:"operator"`
+}
+
+func NewMsgRevokeOperator(name string, owner sdk.AccAddress, operator sdk.AccAddress) MsgRevokeOperator {
+	return MsgRevokeOperator{
+		Name:     name,
+		Owner:    owner,
+		Operator: operator,
+	}
+}
+
+func (msg MsgRevokeOperator) Route() string { return RouterKey }
+
+func (msg MsgRevokeOperator) Type() string { return "revoke_operator" }
+
+func (msg MsgRevokeOperator) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if msg.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Operator.String())
+	}
+	return nil
+}
+
+func (msg MsgRevokeOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRevokeOperator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgOperatorRegisterAddress registers a blockchain address for the owner of the name on behalf of an approved operator
+type MsgOperatorRegisterAddress struct {
+	Name  				string         	`json:"name" yaml:"name"`
+	Operator 			sdk.AccAddress 	`json:"operator" yaml:"operator"`
+	BlockchainId 		string			`json:"blockchain_id" yaml:"blockchain_id"`
+	Index 				string			`json:"index" yaml:"index"`
+	BlockchainAddress 	string			`json:"blockchain_address" yaml:"blockchain_address"`
+}
+
+func NewMsgOperatorRegisterAddress(name string, operator sdk.AccAddress, blockchainId string, index string, blockchainAddress string) MsgOperatorRegisterAddress {
+	return MsgOperatorRegisterAddress{
+		Name: name,
+		Operator: operator,
+		BlockchainId: blockchainId,
+		Index: index,
+		BlockchainAddress: blockchainAddress,
+	}
+}
+
+func (msg MsgOperatorRegisterAddress) Route() string { return RouterKey }
+
+func (msg MsgOperatorRegisterAddress) Type() string { return "operator_register_address" }
+
+func (msg MsgOperatorRegisterAddress) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Operator.String())
+	}
+	err = validateBlockchainId(msg.BlockchainId)
+	if err != nil {
+		return err
+	}
+	err = validateIndex(msg.Index)
+	if err != nil {
+		return err
+	}
+	return validateBlockchainAddress(msg.BlockchainAddress)
+}
+
+func (msg MsgOperatorRegisterAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgOperatorRegisterAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
+
+// MsgOperatorRemoveAddress removes a blockchain address of the owner of the name on behalf of an approved operator
+type MsgOperatorRemoveAddress struct {
+	Name  			string         	`json:"name" yaml:"name"`
+	Operator 		sdk.AccAddress 	`json:"operator" yaml:"operator"`
+	BlockchainId 	string			`json:"blockchain_id" yaml:"blockchain_id"`
+	Index 			string			`json:"index" yaml:"index"`
+}
+
+func NewMsgOperatorRemoveAddress(name string, operator sdk.AccAddress, blockchainId string, index string) MsgOperatorRemoveAddress {
+	return MsgOperatorRemoveAddress{
+		Name: name,
+		Operator: operator,
+		BlockchainId: blockchainId,
+		Index: index,
+	}
+}
+
+func (msg MsgOperatorRemoveAddress) Route() string { return RouterKey }
+
+func (msg MsgOperatorRemoveAddress) Type() string { return "operator_remove_address" }
+
+func (msg MsgOperatorRemoveAddress) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Operator.String())
+	}
+	err = validateBlockchainId(msg.BlockchainId)
+	if err != nil {
+		return err
+	}
+	return validateIndex(msg.Index)
+}
+
+func (msg MsgOperatorRemoveAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgOperatorRemoveAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
+
+// MsgPlaceOffer
+type MsgPlaceOffer struct {
+	Name  		string         	`json:"name" yaml:"name"`
+	Bidder 		sdk.AccAddress 	`json:"bidder" yaml:"bidder"`
+	Amount 		sdk.Coins		`json:"amount" yaml:"amount"`
+	Duration 	time.Duration 	`json:"duration" yaml:"duration"`
+}
+
+func NewMsgPlaceOffer(name string, bidder sdk.AccAddress, amount sdk.Coins, duration time.Duration) MsgPlaceOffer {
+	return MsgPlaceOffer{
+		Name:  name,
+		Bidder: bidder,
+		Amount: amount,
+		Duration: duration,
+	}
+}
+
+func (msg MsgPlaceOffer) Route() string { return RouterKey }
+
+func (msg MsgPlaceOffer) Type() string { return "place_offer" }
+
+func (msg MsgPlaceOffer) ValidateBasic() error {
+	err := ValidateTopLevelName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Bidder.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Bidder.String())
+	}
+	if ! msg.Amount.IsValid() || msg.Amount.AmountOf(config.DefaultDenom).IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid denomination.")
+	}
+	if msg.Duration <= 0 {
+		return ErrInvalidOfferDuration
+	}
+	return nil
+}
+
+func (msg MsgPlaceOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgPlaceOffer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgAcceptOffer
+type MsgAcceptOffer struct {
+	Name  	string         	`json:"name" yaml:"name"`
+	Owner 	sdk.AccAddress 	`json:"owner" yaml:"owner"`
+	Bidder 	sdk.AccAddress 	`json:"bidder" yaml:"bidder"`
+}
+
+func NewMsgAcceptOffer(name string, owner sdk.AccAddress, bidder sdk.AccAddress) MsgAcceptOffer {
+	return MsgAcceptOffer{
+		Name:  name,
+		Owner: owner,
+		Bidder: bidder,
+	}
+}
+
+func (msg MsgAcceptOffer) Route() string { return RouterKey }
+
+func (msg MsgAcceptOffer) Type() string { return "accept_offer" }
+
+func (msg MsgAcceptOffer) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if msg.Bidder.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Bidder.String())
+	}
+	return nil
+}
+
+func (msg MsgAcceptOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgAcceptOffer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgWithdrawOffer
+type MsgWithdrawOffer struct {
+	Name  	string         	`json:"name" yaml:"name"`
+	Bidder 	sdk.AccAddress 	`json:"bidder" yaml:"bidder"`
+}
+
+func NewMsgWithdrawOffer(name string, bidder sdk.AccAddress) MsgWithdrawOffer {
+	return MsgWithdrawOffer{
+		Name:  name,
+		Bidder: bidder,
+	}
+}
+
+func (msg MsgWithdrawOffer) Route() string { return RouterKey }
+
+func (msg MsgWithdrawOffer) Type() string { return "withdraw_offer" }
+
+func (msg MsgWithdrawOffer) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Bidder.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Bidder.String())
+	}
+	return nil
+}
+
+func (msg MsgWithdrawOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgWithdrawOffer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgSetAddresses upserts and removes a batch of blockchain addresses at once. Only the blockchain id and index of
+// the removed records are used.
+type MsgSetAddresses struct {
+	Owner 		sdk.AccAddress 			`json:"owner" yaml:"owner"`
+	Addresses 	[]BlockchainAddressInfo `json:"addresses" yaml:"addresses"`
+	Removals 	[]BlockchainAddressInfo `json:"removals" yaml:"removals"`
+}
+
+func NewMsgSetAddresses(owner sdk.AccAddress, addresses []BlockchainAddressInfo, removals []BlockchainAddressInfo) MsgSetAddresses {
+	return MsgSetAddresses{
+		Owner: owner,
+		Addresses: addresses,
+		Removals: removals,
+	}
+}
+
+func (msg MsgSetAddresses) Route() string { return RouterKey }
+
+func (msg MsgSetAddresses) Type() string { return "set_addresses" }
+
+func (msg MsgSetAddresses) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	if len(msg.Addresses) + len(msg.Removals) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAddressBatch, "Batch is empty.")
+	}
+
+	// a record can appear only once, otherwise the outcome would depend on the order of application
+	seen := make(map[string]bool)
+
+	for _, address := range append(append([]BlockchainAddressInfo{}, msg.Addresses...), msg.Removals...) {
+		err := validateBlockchainId(address.BlockchainId)
+		if err != nil {
+			return err
+		}
+
+		err = validateIndex(address.Index)
+		if err != nil {
+			return err
+		}
+
+		key := address.BlockchainId + Separator + address.Index
+		if seen[key] {
+			return sdkerrors.Wrapf(ErrInvalidAddressBatch, "Duplicate record %s.", key)
+		}
+		seen[key] = true
+	}
+
+	for _, address := range msg.Addresses {
+		err := validateBlockchainAddress(address.BlockchainAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgSetAddresses) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetAddresses) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgStartTransfer
+type MsgStartTransfer struct {
+	Name  		string         	`json:"name" yaml:"name"`
+	Owner 		sdk.AccAddress 	`json:"owner" yaml:"owner"`
+	NewOwner 	sdk.AccAddress 	`json:"new_owner" yaml:"new_owner"`
+}
+
+func NewMsgStartTransfer(name string, owner sdk.AccAddress, newOwner sdk.AccAddress) MsgStartTransfer {
+	return MsgStartTransfer{
+		Name:  name,
+		Owner: owner,
+		NewOwner: newOwner,
+	}
+}
+
+func (msg MsgStartTransfer) Route() string { return RouterKey }
+
+func (msg MsgStartTransfer) Type() string { return "start_transfer" }
+
+func (msg MsgStartTransfer) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if msg.NewOwner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.NewOwner.String())
+	}
+	return nil
+}
+
+func (msg MsgStartTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgStartTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgCancelTransfer
+type MsgCancelTransfer struct {
+	Name  	string         	`json:"name" yaml:"name"`
+	Owner 	sdk.AccAddress 	`json:"owner" yaml:"owner"`
+}
+
+func NewMsgCancelTransfer(name string, owner sdk.AccAddress) MsgCancelTransfer {
+	return MsgCancelTransfer{
+		Name:  name,
+		Owner: owner,
+	}
+}
+
+func (msg MsgCancelTransfer) Route() string { return RouterKey }
+
+func (msg MsgCancelTransfer) Type() string { return "cancel_transfer" }
+
+func (msg MsgCancelTransfer) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	return nil
+}
+
+func (msg MsgCancelTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCancelTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRetryFailedQueueItem can be sent by anyone, the item is processed exactly as the end blocker would have.
+type MsgRetryFailedQueueItem struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Action string         `json:"action" yaml:"action"`
+	Name   string         `json:"name" yaml:"name"`
+	Bidder sdk.AccAddress `json:"bidder" yaml:"bidder"`
+}
+
+func NewMsgRetryFailedQueueItem(sender sdk.AccAddress, action string, name string, bidder sdk.AccAddress) MsgRetryFailedQueueItem {
+	return MsgRetryFailedQueueItem{
+		Sender: sender,
+		Action: action,
+		Name:   name,
+		Bidder: bidder,
+	}
+}
+
+func (msg MsgRetryFailedQueueItem) Route() string { return RouterKey }
+
+func (msg MsgRetryFailedQueueItem) Type() string { return "retry_failed_queue_item" }
+
+func (msg MsgRetryFailedQueueItem) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender.String())
+	}
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	switch msg.Action {
+	case EventTypeSettleAuction, EventTypeCompleteTransfer, EventTypeExpiredName:
+	case EventTypeExpireOffer:
+		if msg.Bidder.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Offers are retried by name and bidder")
+		}
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Unknown queue action: %s", msg.Action)
+	}
+	return nil
+}
+
+func (msg MsgRetryFailedQueueItem) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRetryFailedQueueItem) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
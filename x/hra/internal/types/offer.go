@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"time"
+)
+
+// Offer is placed by a buyer on a registered name. The amount stays in escrow until the owner accepts the offer,
+// the bidder withdraws it or it expires.
+type Offer struct {
+	Name       string         `json:"name" yaml:"name"`
+	Bidder     sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Amount     sdk.Coins      `json:"amount" yaml:"amount"`
+	ExpiryTime time.Time      `json:"expiry_time" yaml:"expiry_time"`
+}
+
+func NewOffer(name string, bidder sdk.AccAddress, amount sdk.Coins, expiryTime time.Time) Offer {
+	return Offer{
+		Name:       name,
+		Bidder:     bidder,
+		Amount:     amount,
+		ExpiryTime: expiryTime,
+	}
+}
+
+func (o Offer) String() string {
+	return fmt.Sprintf(`Name: %s
+Bidder: %s
+Amount: %s
+Expiry time: %s`, o.Name, o.Bidder, o.Amount, o.ExpiryTime)
+}
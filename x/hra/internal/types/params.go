@@ -16,6 +16,20 @@ const (
 
 	DefaultNameInfoMaxDuration  = time.Hour * 24 * 365 * 3
 
+	DefaultNameInfoGracePeriod 		= time.Hour * 24 * 30
+	DefaultNameInfoRedemptionPeriod = time.Hour * 24 * 30
+
+	DefaultAuctionRevealDuration = time.Hour * 24 * 2
+
+	DefaultOfferMaxDuration = time.Hour * 24 * 30
+
+	DefaultNameHistoryRetention uint64 = 100
+
+	DefaultTransferDelay = time.Hour * 24 * 2
+
+	DefaultTextRecordMaxCount 		= 32
+	DefaultTextRecordMaxValueLength = 1024
+
 	NameConstraintBlock = 750
 )
 
@@ -36,15 +50,41 @@ var (
 		"bnb",
 	}
 
+	// DefaultReservedNames cannot be registered until released through governance
+	DefaultReservedNames = []string {
+		"anatha",
+		"treasury",
+		"governance",
+		"hra",
+	}
+
 	KeyNameInfoDuration			= []byte("NameInfoDuration")
+	KeyNameInfoGracePeriod		= []byte("NameInfoGracePeriod")
+	KeyNameInfoRedemptionPeriod = []byte("NameInfoRedemptionPeriod")
 
 	KeyNameInfoRegistrationFee 	= []byte("NameInfoRegistrationFee")
 	KeyNameInfoRenewalFee 		= []byte("NameInfoRenewalFee")
+	KeyNameInfoRedemptionFee 	= []byte("NameInfoRedemptionFee")
 
 	KeyAddressCredits 			= []byte("AddressCredits")
 	KeyAddressRegistrationFee 	= []byte("AddressRegistrationFee")
+	KeySubnameRegistrationFee 	= []byte("SubnameRegistrationFee")
 
 	KeyNameInfoMaxDuration     = []byte("NameInfoMaxDuration")
+
+	KeyAuctionRevealDuration   = []byte("AuctionRevealDuration")
+
+	KeyTextRecordMaxCount 		= []byte("TextRecordMaxCount")
+	KeyTextRecordMaxValueLength = []byte("TextRecordMaxValueLength")
+	KeyTextRecordFee 			= []byte("TextRecordFee")
+
+	KeyNameInfoPricingSchedule 	= []byte("NameInfoPricingSchedule")
+
+	KeyOfferMaxDuration 		= []byte("OfferMaxDuration")
+
+	KeyNameHistoryRetention 	= []byte("NameHistoryRetention")
+
+	KeyTransferDelay 			= []byte("TransferDelay")
 )
 
 func ParamKeyTable() params.KeyTable {
@@ -54,21 +94,49 @@ func ParamKeyTable() params.KeyTable {
 type Params struct {
 	NameInfoDuration		time.Duration 	`json:"nameinfo_duration" yaml:"nameinfo_duration"`
 	NameInfoMaxDuration 	time.Duration 	`json:"nameinfo_max_duration" yaml:"nameinfo_max_duration"`
+	NameInfoGracePeriod 	time.Duration 	`json:"nameinfo_grace_period" yaml:"nameinfo_grace_period"`
+	NameInfoRedemptionPeriod time.Duration 	`json:"nameinfo_redemption_period" yaml:"nameinfo_redemption_period"`
 	NameInfoRegistrationFee sdk.Coins 		`json:"registration_fee" yaml:"registration_fee"`
 	NameInfoRenewalFee 		sdk.Coins 		`json:"renewal_fee" yaml:"renewal_fee"`
+	NameInfoRedemptionFee 	sdk.Coins 		`json:"redemption_fee" yaml:"redemption_fee"`
 	AddressCredits 			sdk.Int			`json:"address_credits" yaml:"address_credits"`
 	AddressRegistrationFee	sdk.Coins		`json:"address_registration_fee" yaml:"address_registration_fee"`
+	SubnameRegistrationFee	sdk.Coins		`json:"subname_registration_fee" yaml:"subname_registration_fee"`
+	AuctionRevealDuration	time.Duration	`json:"auction_reveal_duration" yaml:"auction_reveal_duration"`
+	TextRecordMaxCount		uint64			`json:"text_record_max_count" yaml:"text_record_max_count"`
+	TextRecordMaxValueLength uint64			`json:"text_record_max_value_length" yaml:"text_record_max_value_length"`
+	TextRecordFee			sdk.Coins		`json:"text_record_fee" yaml:"text_record_fee"`
+	NameInfoPricingSchedule PricingSchedule	`json:"pricing_schedule" yaml:"pricing_schedule"`
+	OfferMaxDuration		time.Duration	`json:"offer_max_duration" yaml:"offer_max_duration"`
+	NameHistoryRetention	uint64			`json:"name_history_retention" yaml:"name_history_retention"`
+	TransferDelay			time.Duration	`json:"transfer_delay" yaml:"transfer_delay"`
 }
 
-func NewParams(nameInfoDuration time.Duration, nameInfoMaxDuration time.Duration, nameInfoRegistrationFee sdk.Coins,
-	nameInfoRenewalFee sdk.Coins, addressCredits sdk.Int, addressRegistrationFee sdk.Coins) Params {
+func NewParams(nameInfoDuration time.Duration, nameInfoMaxDuration time.Duration, nameInfoGracePeriod time.Duration,
+	nameInfoRedemptionPeriod time.Duration, nameInfoRegistrationFee sdk.Coins, nameInfoRenewalFee sdk.Coins,
+	nameInfoRedemptionFee sdk.Coins, addressCredits sdk.Int, addressRegistrationFee sdk.Coins, subnameRegistrationFee sdk.Coins, auctionRevealDuration time.Duration,
+	textRecordMaxCount uint64, textRecordMaxValueLength uint64, textRecordFee sdk.Coins, nameInfoPricingSchedule PricingSchedule,
+	offerMaxDuration time.Duration, nameHistoryRetention uint64,
+	transferDelay time.Duration) Params {
 	return Params{
 		NameInfoDuration: nameInfoDuration,
 		NameInfoMaxDuration: nameInfoMaxDuration,
+		NameInfoGracePeriod: nameInfoGracePeriod,
+		NameInfoRedemptionPeriod: nameInfoRedemptionPeriod,
 		NameInfoRegistrationFee: nameInfoRegistrationFee,
 		NameInfoRenewalFee: nameInfoRenewalFee,
+		NameInfoRedemptionFee: nameInfoRedemptionFee,
 		AddressCredits: addressCredits,
 		AddressRegistrationFee: addressRegistrationFee,
+		SubnameRegistrationFee: subnameRegistrationFee,
+		AuctionRevealDuration: auctionRevealDuration,
+		TextRecordMaxCount: textRecordMaxCount,
+		TextRecordMaxValueLength: textRecordMaxValueLength,
+		TextRecordFee: textRecordFee,
+		NameInfoPricingSchedule: nameInfoPricingSchedule,
+		OfferMaxDuration: offerMaxDuration,
+		NameHistoryRetention: nameHistoryRetention,
+		TransferDelay: transferDelay,
 	}
 }
 
@@ -77,16 +145,40 @@ func (p Params) String() string {
 	return fmt.Sprintf(`Params:
   NameInfo Duration:     %s
   NameInfo Max Duration: %s
+  NameInfo Grace Period: %s
+  NameInfo Redemption Period: %s
   NameInfoRegistrationFee: %s
   NameInfoRenewalFee: %s
+  NameInfoRedemptionFee: %s
   AddressCredits: %s
-  AddressRegistrationFee: %s`,
+  AddressRegistrationFee: %s
+  SubnameRegistrationFee: %s
+  AuctionRevealDuration: %s
+  TextRecordMaxCount: %d
+  TextRecordMaxValueLength: %d
+  TextRecordFee: %s
+  NameInfoPricingSchedule: %s
+  OfferMaxDuration: %s
+  NameHistoryRetention: %d
+  TransferDelay: %s`,
 		p.NameInfoDuration,
 		p.NameInfoMaxDuration,
+		p.NameInfoGracePeriod,
+		p.NameInfoRedemptionPeriod,
 		p.NameInfoRegistrationFee,
 		p.NameInfoRenewalFee,
+		p.NameInfoRedemptionFee,
 		p.AddressCredits,
 		p.AddressRegistrationFee,
+		p.SubnameRegistrationFee,
+		p.AuctionRevealDuration,
+		p.TextRecordMaxCount,
+		p.TextRecordMaxValueLength,
+		p.TextRecordFee,
+		p.NameInfoPricingSchedule,
+		p.OfferMaxDuration,
+		p.NameHistoryRetention,
+		p.TransferDelay,
 	)
 }
 
@@ -95,10 +187,22 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyNameInfoDuration, &p.NameInfoDuration, validateNameInfoDuration),
 		params.NewParamSetPair(KeyNameInfoMaxDuration, &p.NameInfoMaxDuration, validateNameInfoDuration),
+		params.NewParamSetPair(KeyNameInfoGracePeriod, &p.NameInfoGracePeriod, validateNameInfoPeriod),
+		params.NewParamSetPair(KeyNameInfoRedemptionPeriod, &p.NameInfoRedemptionPeriod, validateNameInfoPeriod),
 		params.NewParamSetPair(KeyNameInfoRegistrationFee, &p.NameInfoRegistrationFee, validateFee),
 		params.NewParamSetPair(KeyNameInfoRenewalFee, &p.NameInfoRenewalFee, validateFee),
+		params.NewParamSetPair(KeyNameInfoRedemptionFee, &p.NameInfoRedemptionFee, validateFee),
 		params.NewParamSetPair(KeyAddressCredits, &p.AddressCredits, validateAddressCredits),
 		params.NewParamSetPair(KeyAddressRegistrationFee, &p.AddressRegistrationFee, validateFee),
+		params.NewParamSetPair(KeySubnameRegistrationFee, &p.SubnameRegistrationFee, validateFee),
+		params.NewParamSetPair(KeyAuctionRevealDuration, &p.AuctionRevealDuration, validateAuctionRevealDuration),
+		params.NewParamSetPair(KeyTextRecordMaxCount, &p.TextRecordMaxCount, validateTextRecordLimit),
+		params.NewParamSetPair(KeyTextRecordMaxValueLength, &p.TextRecordMaxValueLength, validateTextRecordLimit),
+		params.NewParamSetPair(KeyTextRecordFee, &p.TextRecordFee, validateFee),
+		params.NewParamSetPair(KeyNameInfoPricingSchedule, &p.NameInfoPricingSchedule, validatePricingSchedule),
+		params.NewParamSetPair(KeyOfferMaxDuration, &p.OfferMaxDuration, validateOfferMaxDuration),
+		params.NewParamSetPair(KeyNameHistoryRetention, &p.NameHistoryRetention, ValidateNameHistoryRetention),
+		params.NewParamSetPair(KeyTransferDelay, &p.TransferDelay, validateTransferDelay),
 	}
 }
 
@@ -107,16 +211,31 @@ func DefaultParams() Params {
 	defaultNameInfoCoinFee, _ := 			sdk.ConvertCoin(sdk.NewInt64Coin("anatha", 1), "pin")
 	defaultNameInfoCoinsFee := 				sdk.NewCoins(defaultNameInfoCoinFee)
 
+	defaultNameInfoRedemptionCoinFee, _ := sdk.ConvertCoin(sdk.NewInt64Coin("anatha", 5), "pin")
+	defaultNameInfoRedemptionCoinsFee := 	sdk.NewCoins(defaultNameInfoRedemptionCoinFee)
+
 	defaultAddressRegistrationCoinFee, _ := sdk.ConvertCoin(sdk.NewInt64Coin("anatha", 1), "pin")
 	defaultAddressRegistrationCoinsFee := 	sdk.NewCoins(defaultAddressRegistrationCoinFee)
 
 	return NewParams(
 		DefaultNameInfoDuration,
 		DefaultNameInfoMaxDuration,
+		DefaultNameInfoGracePeriod,
+		DefaultNameInfoRedemptionPeriod,
 		defaultNameInfoCoinsFee,
 		defaultNameInfoCoinsFee,
+		defaultNameInfoRedemptionCoinsFee,
 		DefaultAddressCredits,
 		defaultAddressRegistrationCoinsFee,
+		defaultAddressRegistrationCoinsFee,
+		DefaultAuctionRevealDuration,
+		DefaultTextRecordMaxCount,
+		DefaultTextRecordMaxValueLength,
+		defaultAddressRegistrationCoinsFee,
+		DefaultPricingSchedule(),
+		DefaultOfferMaxDuration,
+		DefaultNameHistoryRetention,
+		DefaultTransferDelay,
 	)
 }
 
@@ -129,6 +248,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateNameInfoPeriod(p.NameInfoGracePeriod); err != nil {
+		return err
+	}
+
+	if err := validateNameInfoPeriod(p.NameInfoRedemptionPeriod); err != nil {
+		return err
+	}
+
 	if err := validateFee(p.NameInfoRegistrationFee); err != nil {
 		return err
 	}
@@ -137,6 +264,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFee(p.NameInfoRedemptionFee); err != nil {
+		return err
+	}
+
 	if err := validateAddressCredits(p.AddressCredits); err != nil {
 		return err
 	}
@@ -145,6 +276,42 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFee(p.SubnameRegistrationFee); err != nil {
+		return err
+	}
+
+	if err := validateAuctionRevealDuration(p.AuctionRevealDuration); err != nil {
+		return err
+	}
+
+	if err := validateTextRecordLimit(p.TextRecordMaxCount); err != nil {
+		return err
+	}
+
+	if err := validateTextRecordLimit(p.TextRecordMaxValueLength); err != nil {
+		return err
+	}
+
+	if err := validateFee(p.TextRecordFee); err != nil {
+		return err
+	}
+
+	if err := validatePricingSchedule(p.NameInfoPricingSchedule); err != nil {
+		return err
+	}
+
+	if err := validateOfferMaxDuration(p.OfferMaxDuration); err != nil {
+		return err
+	}
+
+	if err := ValidateNameHistoryRetention(p.NameHistoryRetention); err != nil {
+		return err
+	}
+
+	if err := validateTransferDelay(p.TransferDelay); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -161,6 +328,19 @@ func validateNameInfoDuration(i interface{}) error {
 	return nil
 }
 
+func validateNameInfoPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("name info period must not be negative: %d", v)
+	}
+
+	return nil
+}
+
 func validateFee(i interface{}) error {
 	v, ok := i.(sdk.Coins)
 	if !ok {
@@ -187,3 +367,69 @@ func validateAddressCredits(i interface{}) error {
 	return nil
 }
 
+
+
+func validateAuctionRevealDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("auction reveal duration must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateTextRecordLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("text record limit must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateOfferMaxDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("offer max duration must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func ValidateNameHistoryRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("name history retention must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateTransferDelay(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("transfer delay must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/anathatech/project-anatha/config"
+)
+
+// PricingTier sets the registration fee of names up to MaxLength characters.
+// Purely numeric names in the tier are charged NumericMultiplier times the fee, a zero multiplier disables the premium.
+type PricingTier struct {
+	MaxLength 			uint64 		`json:"max_length" yaml:"max_length"`
+	Fee 				sdk.Coins 	`json:"fee" yaml:"fee"`
+	NumericMultiplier 	sdk.Dec 	`json:"numeric_multiplier" yaml:"numeric_multiplier"`
+}
+
+func NewPricingTier(maxLength uint64, fee sdk.Coins, numericMultiplier sdk.Dec) PricingTier {
+	return PricingTier{
+		MaxLength: maxLength,
+		Fee: fee,
+		NumericMultiplier: numericMultiplier,
+	}
+}
+
+func (t PricingTier) String() string {
+	return fmt.Sprintf(`MaxLength: %d, Fee: %s, NumericMultiplier: %s`, t.MaxLength, t.Fee, t.NumericMultiplier)
+}
+
+// PricingSchedule holds the pricing tiers ordered by ascending MaxLength.
+// Names longer than the last tier are charged the flat NameInfoRegistrationFee.
+type PricingSchedule []PricingTier
+
+func (s PricingSchedule) String() string {
+	if len(s) == 0 {
+		return "[]"
+	}
+
+	tiers := make([]string, len(s))
+	for i, tier := range s {
+		tiers[i] = tier.String()
+	}
+
+	return "\n    " + strings.Join(tiers, "\n    ")
+}
+
+// GetFee returns the fee of a single registration period of the name according to the schedule, falling back to
+// the flat fee for names which are not covered by any tier.
+func (s PricingSchedule) GetFee(name string, flatFee sdk.Coins) sdk.Coins {
+	for _, tier := range s {
+		if uint64(len(name)) > tier.MaxLength {
+			continue
+		}
+
+		if isNumericName(name) && tier.hasNumericPremium() {
+			return multiplyCoins(tier.Fee, tier.NumericMultiplier)
+		}
+
+		return tier.Fee
+	}
+
+	return flatFee
+}
+
+func (t PricingTier) hasNumericPremium() bool {
+	return ! t.NumericMultiplier.IsNil() && ! t.NumericMultiplier.IsZero()
+}
+
+func isNumericName(name string) bool {
+	for _, c := range name {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return len(name) > 0
+}
+
+func multiplyCoins(coins sdk.Coins, multiplier sdk.Dec) sdk.Coins {
+	result := sdk.NewCoins()
+
+	for _, coin := range coins {
+		result = result.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(multiplier).TruncateInt()))
+	}
+
+	return result
+}
+
+func DefaultPricingSchedule() PricingSchedule {
+	threeLetterFee, _ := sdk.ConvertCoin(sdk.NewInt64Coin("anatha", 10), "pin")
+	fourLetterFee, _ := sdk.ConvertCoin(sdk.NewInt64Coin("anatha", 5), "pin")
+
+	return PricingSchedule{
+		NewPricingTier(3, sdk.NewCoins(threeLetterFee), sdk.ZeroDec()),
+		NewPricingTier(4, sdk.NewCoins(fourLetterFee), sdk.ZeroDec()),
+	}
+}
+
+func validatePricingSchedule(i interface{}) error {
+	v, ok := i.(PricingSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func (s PricingSchedule) Validate() error {
+	var previousMaxLength uint64
+
+	for _, tier := range s {
+		if tier.MaxLength <= previousMaxLength {
+			return fmt.Errorf("pricing tiers must be ordered by strictly increasing max length: %d", tier.MaxLength)
+		}
+
+		if ! tier.Fee.IsValid() || ! tier.Fee.AmountOf(config.DefaultDenom).IsPositive() {
+			return fmt.Errorf("invalid pricing tier fee: %s. expected: %s", tier.Fee, config.DefaultDenom)
+		}
+
+		if tier.hasNumericPremium() && tier.NumericMultiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("numeric multiplier must be zero or at least 1: %s", tier.NumericMultiplier)
+		}
+
+		previousMaxLength = tier.MaxLength
+	}
+
+	return nil
+}
@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	gov "github.com/anathatech/project-anatha/x/governance"
 )
@@ -9,6 +12,11 @@ import (
 const (
 	ProposalTypeRegisterBlockchainId 	= "RegisterBlockchainId"
 	ProposalTypeRemoveBlockchainId 		= "RemoveBlockchainId"
+	ProposalTypeSetPricingSchedule 		= "SetPricingSchedule"
+	ProposalTypeReserveNames 			= "ReserveNames"
+	ProposalTypeReleaseNames 			= "ReleaseNames"
+	ProposalTypeRevokeName 				= "RevokeName"
+	ProposalTypeSetNameHistoryRetention = "SetNameHistoryRetention"
 )
 
 func init() {
@@ -16,17 +24,28 @@ func init() {
 	gov.RegisterProposalTypeCodec(RegisterBlockchainIdProposal{}, "hra/RegisterBlockchainIdProposal")
 	gov.RegisterProposalType(ProposalTypeRemoveBlockchainId)
 	gov.RegisterProposalTypeCodec(RemoveBlockchainIdProposal{}, "hra/RemoveBlockchainIdProposal")
+	gov.RegisterProposalType(ProposalTypeSetPricingSchedule)
+	gov.RegisterProposalTypeCodec(SetPricingScheduleProposal{}, "hra/SetPricingScheduleProposal")
+	gov.RegisterProposalType(ProposalTypeReserveNames)
+	gov.RegisterProposalTypeCodec(ReserveNamesProposal{}, "hra/ReserveNamesProposal")
+	gov.RegisterProposalType(ProposalTypeReleaseNames)
+	gov.RegisterProposalTypeCodec(ReleaseNamesProposal{}, "hra/ReleaseNamesProposal")
+	gov.RegisterProposalType(ProposalTypeRevokeName)
+	gov.RegisterProposalTypeCodec(RevokeNameProposal{}, "hra/RevokeNameProposal")
+	gov.RegisterProposalType(ProposalTypeSetNameHistoryRetention)
+	gov.RegisterProposalTypeCodec(SetNameHistoryRetentionProposal{}, "hra/SetNameHistoryRetentionProposal")
 }
 
 // RegisterBlockchainIdProposal
 type RegisterBlockchainIdProposal struct {
-	Title       	string `json:"title" yaml:"title"`
-	Description 	string `json:"description" yaml:"description"`
-	BlockchainId 	string `json:"blockchain_id" yaml:"blockchain_id"`
+	Title       		string `json:"title" yaml:"title"`
+	Description 		string `json:"description" yaml:"description"`
+	BlockchainId 		string `json:"blockchain_id" yaml:"blockchain_id"`
+	AddressValidator 	string `json:"address_validator" yaml:"address_validator"` // optional, defaults to the built-in validator of the blockchain id
 }
 
-func NewRegisterBlockchainIdProposal(title string, description string, blockchainId string) gov.Content {
-	return RegisterBlockchainIdProposal{title, description, blockchainId}
+func NewRegisterBlockchainIdProposal(title string, description string, blockchainId string, addressValidator string) gov.Content {
+	return RegisterBlockchainIdProposal{title, description, blockchainId, addressValidator}
 }
 
 // Implements Proposal Interface
@@ -41,6 +60,11 @@ func (p RegisterBlockchainIdProposal) ValidateBasic() error {
 	if err := validateBlockchainId(p.BlockchainId); err != nil {
 		return err
 	}
+	if p.AddressValidator != "" {
+		if err := validateAddressValidator(p.AddressValidator); err != nil {
+			return err
+		}
+	}
 	return gov.ValidateAbstract(p)
 }
 
@@ -49,7 +73,8 @@ func (p RegisterBlockchainIdProposal) String() string {
   Title:       %s
   Description: %s
   Blockchain Id: %s
-`, p.Title, p.Description, p.BlockchainId)
+  Address Validator: %s
+`, p.Title, p.Description, p.BlockchainId, p.AddressValidator)
 }
 
 // RemoveBlockchainIdProposal
@@ -84,4 +109,196 @@ func (p RemoveBlockchainIdProposal) String() string {
   Description: %s
   Blockchain Id: %s
 `, p.Title, p.Description, p.BlockchainId)
-}
\ No newline at end of file
+}
+
+// SetPricingScheduleProposal
+type SetPricingScheduleProposal struct {
+	Title       		string 			`json:"title" yaml:"title"`
+	Description 		string 			`json:"description" yaml:"description"`
+	PricingSchedule 	PricingSchedule `json:"pricing_schedule" yaml:"pricing_schedule"`
+}
+
+func NewSetPricingScheduleProposal(title string, description string, pricingSchedule PricingSchedule) gov.Content {
+	return SetPricingScheduleProposal{title, description, pricingSchedule}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = SetPricingScheduleProposal{}
+
+// nolint
+func (p SetPricingScheduleProposal) GetTitle() string       { return p.Title }
+func (p SetPricingScheduleProposal) GetDescription() string { return p.Description }
+func (p SetPricingScheduleProposal) ProposalRoute() string  { return RouterKey }
+func (p SetPricingScheduleProposal) ProposalType() string   { return ProposalTypeSetPricingSchedule }
+func (p SetPricingScheduleProposal) ValidateBasic() error 	{
+	if err := p.PricingSchedule.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPricingSchedule, err.Error())
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p SetPricingScheduleProposal) String() string {
+	return fmt.Sprintf(`Set Pricing Schedule:
+  Title:       %s
+  Description: %s
+  Pricing Schedule: %s
+`, p.Title, p.Description, p.PricingSchedule)
+}
+
+// ReserveNamesProposal
+type ReserveNamesProposal struct {
+	Title       	string 		`json:"title" yaml:"title"`
+	Description 	string 		`json:"description" yaml:"description"`
+	Names 			[]string 	`json:"names" yaml:"names"`
+}
+
+func NewReserveNamesProposal(title string, description string, names []string) gov.Content {
+	return ReserveNamesProposal{title, description, names}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = ReserveNamesProposal{}
+
+// nolint
+func (p ReserveNamesProposal) GetTitle() string       { return p.Title }
+func (p ReserveNamesProposal) GetDescription() string { return p.Description }
+func (p ReserveNamesProposal) ProposalRoute() string  { return RouterKey }
+func (p ReserveNamesProposal) ProposalType() string   { return ProposalTypeReserveNames }
+func (p ReserveNamesProposal) ValidateBasic() error 	{
+	if err := validateNameList(p.Names); err != nil {
+		return err
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p ReserveNamesProposal) String() string {
+	return fmt.Sprintf(`Reserve Names:
+  Title:       %s
+  Description: %s
+  Names: %s
+`, p.Title, p.Description, strings.Join(p.Names, ", "))
+}
+
+// ReleaseNamesProposal
+type ReleaseNamesProposal struct {
+	Title       	string 		`json:"title" yaml:"title"`
+	Description 	string 		`json:"description" yaml:"description"`
+	Names 			[]string 	`json:"names" yaml:"names"`
+}
+
+func NewReleaseNamesProposal(title string, description string, names []string) gov.Content {
+	return ReleaseNamesProposal{title, description, names}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = ReleaseNamesProposal{}
+
+// nolint
+func (p ReleaseNamesProposal) GetTitle() string       { return p.Title }
+func (p ReleaseNamesProposal) GetDescription() string { return p.Description }
+func (p ReleaseNamesProposal) ProposalRoute() string  { return RouterKey }
+func (p ReleaseNamesProposal) ProposalType() string   { return ProposalTypeReleaseNames }
+func (p ReleaseNamesProposal) ValidateBasic() error 	{
+	if err := validateNameList(p.Names); err != nil {
+		return err
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p ReleaseNamesProposal) String() string {
+	return fmt.Sprintf(`Release Names:
+  Title:       %s
+  Description: %s
+  Names: %s
+`, p.Title, p.Description, strings.Join(p.Names, ", "))
+}
+
+// RevokeNameProposal
+type RevokeNameProposal struct {
+	Title       	string `json:"title" yaml:"title"`
+	Description 	string `json:"description" yaml:"description"`
+	Name 			string `json:"name" yaml:"name"`
+}
+
+func NewRevokeNameProposal(title string, description string, name string) gov.Content {
+	return RevokeNameProposal{title, description, name}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = RevokeNameProposal{}
+
+// nolint
+func (p RevokeNameProposal) GetTitle() string       { return p.Title }
+func (p RevokeNameProposal) GetDescription() string { return p.Description }
+func (p RevokeNameProposal) ProposalRoute() string  { return RouterKey }
+func (p RevokeNameProposal) ProposalType() string   { return ProposalTypeRevokeName }
+func (p RevokeNameProposal) ValidateBasic() error 	{
+	if err := validateName(p.Name); err != nil {
+		return err
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p RevokeNameProposal) String() string {
+	return fmt.Sprintf(`Revoke Name:
+  Title:       %s
+  Description: %s
+  Name: %s
+`, p.Title, p.Description, p.Name)
+}
+
+func validateNameList(names []string) error {
+	if len(names) == 0 {
+		return sdkerrors.Wrap(ErrNameNotValid, "no names given")
+	}
+
+	seen := make(map[string]bool, len(names))
+
+	for _, name := range names {
+		if err := ValidateTopLevelName(name); err != nil {
+			return sdkerrors.Wrap(err, name)
+		}
+
+		if seen[name] {
+			return sdkerrors.Wrapf(ErrNameNotValid, "duplicate name %s", name)
+		}
+
+		seen[name] = true
+	}
+
+	return nil
+}
+
+// SetNameHistoryRetentionProposal
+type SetNameHistoryRetentionProposal struct {
+	Title       	string 	`json:"title" yaml:"title"`
+	Description 	string 	`json:"description" yaml:"description"`
+	Retention 		uint64 	`json:"retention" yaml:"retention"`
+}
+
+func NewSetNameHistoryRetentionProposal(title string, description string, retention uint64) gov.Content {
+	return SetNameHistoryRetentionProposal{title, description, retention}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = SetNameHistoryRetentionProposal{}
+
+// nolint
+func (p SetNameHistoryRetentionProposal) GetTitle() string       { return p.Title }
+func (p SetNameHistoryRetentionProposal) GetDescription() string { return p.Description }
+func (p SetNameHistoryRetentionProposal) ProposalRoute() string  { return RouterKey }
+func (p SetNameHistoryRetentionProposal) ProposalType() string   { return ProposalTypeSetNameHistoryRetention }
+func (p SetNameHistoryRetentionProposal) ValidateBasic() error 	{
+	if err := ValidateNameHistoryRetention(p.Retention); err != nil {
+		return sdkerrors.Wrap(ErrInvalidHistoryRetention, err.Error())
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p SetNameHistoryRetentionProposal) String() string {
+	return fmt.Sprintf(`Set Name History Retention:
+  Title:       %s
+  Description: %s
+  Retention: %d
+`, p.Title, p.Description, p.Retention)
+}
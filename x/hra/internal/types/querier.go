@@ -4,19 +4,26 @@ import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"strings"
+	"time"
 )
 
 
 type QueryResNameInfo struct {
-	NameInfo 	NameInfo 					`json:"name_info" yaml:"name_info"`
-	Credits 	sdk.Int 					`json:"credits" yaml:"credits"`
-	Addresses 	[]BlockchainAddressInfo 	`json:"addresses" yaml:"addresses"`
+	NameInfo 			NameInfo 					`json:"name_info" yaml:"name_info"`
+	Status 				string 						`json:"status" yaml:"status"`
+	GraceEndTime 		time.Time 					`json:"grace_end_time" yaml:"grace_end_time"`
+	RedemptionEndTime 	time.Time 					`json:"redemption_end_time" yaml:"redemption_end_time"`
+	Credits 			sdk.Int 					`json:"credits" yaml:"credits"`
+	Addresses 			[]BlockchainAddressInfo 	`json:"addresses" yaml:"addresses"`
 }
 
 func (n QueryResNameInfo) String() string {
 	return fmt.Sprintf(`%s
+Status: %s
+Grace end time: %s
+Redemption end time: %s
 %s
-%s`, n.NameInfo, n.Credits, n.Addresses)
+%s`, n.NameInfo, n.Status, n.GraceEndTime, n.RedemptionEndTime, n.Credits, n.Addresses)
 }
 
 type QueryResNameInfos []NameInfo
@@ -60,3 +67,184 @@ type QueryResBlockchainAddresses []BlockchainAddressInfo
 func (n QueryResBlockchainAddresses) String() string {
 	return fmt.Sprintf(`%s`, n)
 }
+
+type QueryResAuctions []Auction
+
+func (n QueryResAuctions) String() string {
+	var auctions []string
+
+	for _, auction := range n {
+		auctions = append(auctions, auction.String())
+	}
+
+	return strings.Join(auctions, "\n")
+}
+
+type QueryResSealedBids []SealedBid
+
+func (n QueryResSealedBids) String() string {
+	var sealedBids []string
+
+	for _, sealedBid := range n {
+		sealedBids = append(sealedBids, sealedBid.String())
+	}
+
+	return strings.Join(sealedBids, "\n")
+}
+
+type QueryResTextRecords []TextRecord
+
+func (n QueryResTextRecords) String() string {
+	var textRecords []string
+
+	for _, textRecord := range n {
+		textRecords = append(textRecords, textRecord.String())
+	}
+
+	return strings.Join(textRecords, "\n")
+}
+
+type QueryResOperators []sdk.AccAddress
+
+func (n QueryResOperators) String() string {
+	var operators []string
+
+	for _, operator := range n {
+		operators = append(operators, operator.String())
+	}
+
+	return strings.Join(operators, "\n")
+}
+
+type QueryResPriceQuote struct {
+	Name 	string 		`json:"name" yaml:"name"`
+	Periods uint64 		`json:"periods" yaml:"periods"`
+	Fee 	sdk.Coins 	`json:"fee" yaml:"fee"`
+}
+
+func (n QueryResPriceQuote) String() string {
+	return fmt.Sprintf(`Name: %s
+Periods: %d
+Fee: %s`, n.Name, n.Periods, n.Fee)
+}
+
+type QueryResOffers []Offer
+
+func (n QueryResOffers) String() string {
+	var offers []string
+
+	for _, offer := range n {
+		offers = append(offers, offer.String())
+	}
+
+	return strings.Join(offers, "\n")
+}
+
+const QueryDefaultLimit = 100
+
+// QueryNamesParams defines the params for the following queries:
+// - 'custom/hra/all-names'
+// - 'custom/hra/names-by-prefix'
+// - 'custom/hra/names-for-sale'
+type QueryNamesParams struct {
+	Page 	int 	`json:"page" yaml:"page"`
+	Limit 	int 	`json:"limit" yaml:"limit"`
+	Prefix 	string 	`json:"prefix" yaml:"prefix"`
+}
+
+func NewQueryNamesParams(page, limit int, prefix string) QueryNamesParams {
+	return QueryNamesParams{
+		Page: page,
+		Limit: limit,
+		Prefix: prefix,
+	}
+}
+
+// QueryExpiringNamesParams defines the params for the 'custom/hra/expiring-names' query
+type QueryExpiringNamesParams struct {
+	Page 	int 		`json:"page" yaml:"page"`
+	Limit 	int 		`json:"limit" yaml:"limit"`
+	From 	time.Time 	`json:"from" yaml:"from"`
+	To 		time.Time 	`json:"to" yaml:"to"`
+}
+
+func NewQueryExpiringNamesParams(page, limit int, from time.Time, to time.Time) QueryExpiringNamesParams {
+	return QueryExpiringNamesParams{
+		Page: page,
+		Limit: limit,
+		From: from,
+		To: to,
+	}
+}
+
+type QueryResNamesPage struct {
+	Total 	int 		`json:"total" yaml:"total"`
+	Names 	[]NameInfo 	`json:"names" yaml:"names"`
+}
+
+func NewQueryResNamesPage(total int, names []NameInfo) QueryResNamesPage {
+	return QueryResNamesPage{
+		Total: total,
+		Names: names,
+	}
+}
+
+func (n QueryResNamesPage) String() string {
+	return fmt.Sprintf(`Total: %d
+%s`, n.Total, QueryResNameInfos(n.Names))
+}
+
+// QueryNameHistoryParams defines the params for the 'custom/hra/name-history' query
+type QueryNameHistoryParams struct {
+	Name 	string 	`json:"name" yaml:"name"`
+	Page 	int 	`json:"page" yaml:"page"`
+	Limit 	int 	`json:"limit" yaml:"limit"`
+}
+
+func NewQueryNameHistoryParams(name string, page, limit int) QueryNameHistoryParams {
+	return QueryNameHistoryParams{
+		Name: name,
+		Page: page,
+		Limit: limit,
+	}
+}
+
+type QueryResNameHistory struct {
+	Total 	int 				`json:"total" yaml:"total"`
+	Entries []NameHistoryEntry 	`json:"entries" yaml:"entries"`
+}
+
+func (n QueryResNameHistory) String() string {
+	var entries []string
+
+	for _, entry := range n.Entries {
+		entries = append(entries, entry.String())
+	}
+
+	return fmt.Sprintf(`Total: %d
+%s`, n.Total, strings.Join(entries, "\n"))
+}
+
+type QueryResFailedQueueItems []FailedQueueItem
+
+func (n QueryResFailedQueueItems) String() string {
+	var items []string
+
+	for _, item := range n {
+		items = append(items, item.String())
+	}
+
+	return strings.Join(items, "\n")
+}
+
+type QueryResPendingTransfers []PendingTransfer
+
+func (n QueryResPendingTransfers) String() string {
+	var transfers []string
+
+	for _, transfer := range n {
+		transfers = append(transfers, transfer.String())
+	}
+
+	return strings.Join(transfers, "\n")
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"time"
+)
+
+// PendingTransfer is a delayed transfer started by the owner. The name is locked until the transfer completes at
+// CompletionTime, and the owner can cancel it before then.
+type PendingTransfer struct {
+	Name           string         `json:"name" yaml:"name"`
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	NewOwner       sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+	CompletionTime time.Time      `json:"completion_time" yaml:"completion_time"`
+}
+
+func NewPendingTransfer(name string, owner sdk.AccAddress, newOwner sdk.AccAddress, completionTime time.Time) PendingTransfer {
+	return PendingTransfer{
+		Name:           name,
+		Owner:          owner,
+		NewOwner:       newOwner,
+		CompletionTime: completionTime,
+	}
+}
+
+func (t PendingTransfer) String() string {
+	return fmt.Sprintf(`Name: %s
+Owner: %s
+New owner: %s
+Completion time: %s`, t.Name, t.Owner, t.NewOwner, t.CompletionTime)
+}
@@ -7,12 +7,19 @@ import (
 	"time"
 )
 
+const (
+	NameStatusActive 		= "active"
+	NameStatusGrace 		= "grace"
+	NameStatusRedemption 	= "redemption"
+)
+
 type NameInfo struct {
 	Name string				`json:"name" yaml:"name"`
 	Owner sdk.AccAddress	`json:"owner" yaml:"owner"`
 	Price sdk.Coins			`json:"price" yaml:"price"`
 	CreationTime time.Time	`json:"creation_time" yaml:"creation_time"`
 	ExpiryTime time.Time	`json:"expiry_time" yaml:"expiry_time"`
+	Parent string			`json:"parent" yaml:"parent"`
 }
 
 func NewNameInfo(name string) NameInfo {
@@ -22,15 +29,21 @@ func NewNameInfo(name string) NameInfo {
 		Price: sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, 0)),
 		CreationTime: time.Time{},
 		ExpiryTime: time.Time{},
+		Parent: "",
 	}
 }
 
+func (h NameInfo) IsSubname() bool {
+	return h.Parent != ""
+}
+
 func (h NameInfo) String() string {
  	return fmt.Sprintf(`Name: %s
 Owner: %s
 Price: %s
 Creation time: %s
-Expiry time: %s`, h.Name, h.Owner, h.Price, h.CreationTime, h.ExpiryTime)
+Expiry time: %s
+Parent: %s`, h.Name, h.Owner, h.Price, h.CreationTime, h.ExpiryTime, h.Parent)
 }
 
 
@@ -84,4 +97,92 @@ func NewAddressCreditsInfo(address sdk.AccAddress, credits sdk.Int) AddressCredi
 func (a AddressCreditsInfo) String() string {
 	return fmt.Sprintf(`Address: %s
 Credits: %s`, a.Address, a.Credits)
-}
\ No newline at end of file
+}
+
+type NameAddressRecordInfo struct {
+	Name                  string                `json:"name" yaml:"name"`
+	BlockchainAddressInfo BlockchainAddressInfo `json:"blockchain_address_info" yaml:"blockchain_address_info"`
+}
+
+func NewNameAddressRecordInfo(name string, blockchainAddress BlockchainAddressInfo) NameAddressRecordInfo {
+	return NameAddressRecordInfo{
+		Name:                  name,
+		BlockchainAddressInfo: blockchainAddress,
+	}
+}
+
+func (a NameAddressRecordInfo) String() string {
+	return fmt.Sprintf(`Name: %s
+Blockchain address: %s`, a.Name, a.BlockchainAddressInfo)
+}
+
+type PrimaryNameInfo struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Name    string         `json:"name" yaml:"name"`
+}
+
+func NewPrimaryNameInfo(address sdk.AccAddress, name string) PrimaryNameInfo {
+	return PrimaryNameInfo{
+		Address: address,
+		Name:    name,
+	}
+}
+
+func (p PrimaryNameInfo) String() string {
+	return fmt.Sprintf(`Address: %s
+Name: %s`, p.Address, p.Name)
+}
+
+type TextRecord struct {
+	Name  string `json:"name" yaml:"name"`
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+func NewTextRecord(name string, key string, value string) TextRecord {
+	return TextRecord{
+		Name:  name,
+		Key:   key,
+		Value: value,
+	}
+}
+
+func (r TextRecord) String() string {
+	return fmt.Sprintf(`Name: %s
+Key: %s
+Value: %s`, r.Name, r.Key, r.Value)
+}
+
+type BlockchainIdAddressValidatorInfo struct {
+	BlockchainId     string `json:"blockchain_id" yaml:"blockchain_id"`
+	AddressValidator string `json:"address_validator" yaml:"address_validator"`
+}
+
+func NewBlockchainIdAddressValidatorInfo(blockchainId string, addressValidator string) BlockchainIdAddressValidatorInfo {
+	return BlockchainIdAddressValidatorInfo{
+		BlockchainId:     blockchainId,
+		AddressValidator: addressValidator,
+	}
+}
+
+func (v BlockchainIdAddressValidatorInfo) String() string {
+	return fmt.Sprintf(`Blockchain Id: %s
+Address Validator: %s`, v.BlockchainId, v.AddressValidator)
+}
+
+type OperatorInfo struct {
+	Name     string         `json:"name" yaml:"name"`
+	Operator sdk.AccAddress `json:"operator" yaml:"operator"`
+}
+
+func NewOperatorInfo(name string, operator sdk.AccAddress) OperatorInfo {
+	return OperatorInfo{
+		Name:     name,
+		Operator: operator,
+	}
+}
+
+func (o OperatorInfo) String() string {
+	return fmt.Sprintf(`Name: %s
+Operator: %s`, o.Name, o.Operator)
+}
@@ -4,16 +4,25 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"regexp"
 	"strconv"
+	"strings"
 )
 
 const (
 	validChar = `[a-z0-9\.,\+\-_]`
 	blockchainAddressMaxLen = 128
+
+	SubnameSeparator = "."
+
+	// MaxPeriods bounds the periods bought with a single message so that multiplying the fee can't overflow, the
+	// keeper further limits them to the maximum duration of a name
+	MaxPeriods uint64 = 1000
 )
 
 var (
 	validBlockchainId = regexp.MustCompile(`^[a-zA-Z0-9_.-]{3,32}$`).MatchString
 	validName = regexp.MustCompile(`^` + validChar + `{3,64}$`).MatchString
+	validLabel = regexp.MustCompile(`^[a-z0-9,\+\-_]{1,60}$`).MatchString
+	validTextRecordKey = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,64}$`).MatchString
 )
 
 func validateName(name string) error {
@@ -24,6 +33,51 @@ func validateName(name string) error {
 	return nil
 }
 
+// ValidateTopLevelName rejects the subname separator so that only the owner of a name can create names beneath it
+func ValidateTopLevelName(name string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+
+	if strings.Contains(name, SubnameSeparator) {
+		return sdkerrors.Wrap(ErrNameNotValid, "subnames are registered by the owner of the parent name")
+	}
+
+	return nil
+}
+
+func validateSubname(name string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+
+	label, parent := SplitSubname(name)
+
+	if ! validLabel(label) {
+		return sdkerrors.Wrap(ErrNameNotValid, "invalid subname label")
+	}
+
+	return ValidateTopLevelName(parent)
+}
+
+func periodsOrDefault(periods uint64) uint64 {
+	if periods == 0 {
+		return 1
+	}
+
+	return periods
+}
+
+// SplitSubname splits a subname into its label and its parent name, e.g. pay.alice into pay and alice
+func SplitSubname(name string) (label string, parent string) {
+	parts := strings.SplitN(name, SubnameSeparator, 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
 func validateBlockchainId(blockchainId string) error {
 	if ! validBlockchainId(blockchainId) {
 		return ErrBlockchainIdNotValid
@@ -52,3 +106,21 @@ func validateBlockchainAddress(blockchainAddress string) error {
 
 	return nil
 }
+
+// Common keys are avatar, email, url, contenthash and pubkey, but any key matching the pattern is accepted.
+// The length of the value is bounded by the TextRecordMaxValueLength param, which is checked by the keeper.
+func validateTextRecordKey(key string) error {
+	if ! validTextRecordKey(key) {
+		return ErrTextRecordKeyNotValid
+	}
+
+	return nil
+}
+
+func validateTextRecordValue(value string) error {
+	if len(value) == 0 {
+		return sdkerrors.Wrap(ErrTextRecordValueNotValid, "value is required")
+	}
+
+	return nil
+}
@@ -10,16 +10,26 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {}
 
 func EndBlocker(ctx sdk.Context, k Keeper) {
 	 k.IterateScheduledDisbursementQueue(ctx, ctx.BlockTime(), func(disbursement types.Disbursement) (stop bool) {
+	 	// disbursements without enough approvals stay in the queue until they are approved, rejected or their approval
+	 	// deadline passed
+	 	if ! disbursement.IsApproved() {
+	 		if ctx.BlockTime().After(k.DisbursementApprovalDeadline(ctx, disbursement)) {
+	 			k.ExpireDisbursement(ctx, disbursement)
+	 		}
 
-	 	_, fromBuyBack, fromTreasury := k.CalculatePinAmountExtended(ctx, disbursement.Amount)
+	 		return false
+	 	}
 
-		err := k.DisburseFunds(ctx, disbursement.Operator, disbursement.Recipient, disbursement.Amount, fromBuyBack, fromTreasury)
+		err := k.ExecuteDisbursement(ctx, disbursement.Operator, disbursement)
 		if err != nil {
-			k.Logger(ctx).Info(err.Error())
+			k.FailDisbursement(ctx, disbursement, err)
 		}
 
 		k.RemoveFromDisbursementQueue(ctx,disbursement.Recipient, disbursement.ScheduledFor)
 
 	 	return false
 	 })
+
+	 k.PruneDisbursementUsage(ctx)
+	 k.PruneLedger(ctx)
 }
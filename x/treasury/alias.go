@@ -3,6 +3,7 @@ package treasury
 import (
 	"github.com/anathatech/project-anatha/x/treasury/internal/keeper"
 	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+	"github.com/anathatech/project-anatha/x/treasury/orderbook"
 )
 
 const (
@@ -16,15 +17,19 @@ const (
 	DistributionProfitsModuleName = types.DistributionProfitsModuleName
 	TreasuryEscrowModuleName = types.TreasuryEscrowModuleName
 	SwapEscrowModuleName	= types.SwapEscrowModuleName
+	OrderBookEscrowModuleName = orderbook.EscrowModuleName
 )
 
 var (
 	// functions aliases
 	NewKeeper                          = keeper.NewKeeper
 	NewQuerier                         = keeper.NewQuerier
+	RegisterInvariants                 = keeper.RegisterInvariants
+	AllInvariants                      = keeper.AllInvariants
 	RegisterCodec                      = types.RegisterCodec
 	NewGenesisState                    = types.NewGenesisState
 	DefaultGenesisState                = types.DefaultGenesisState
+	DefaultParams                      = types.DefaultParams
 	ValidateGenesis                    = types.ValidateGenesis
 	NewMsgAddOperator 					= types.NewMsgAddOperator
 	NewMsgRemoveOperator 				= types.NewMsgRemoveOperator
@@ -33,9 +38,24 @@ var (
 	NewMsgDisburseFromEscrow            = types.NewMsgDisburseFromEscrow
 	NewMsgRevertFromEscrow              = types.NewMsgRevertFromEscrow
 	NewMsgCancelDisbursement			= types.NewMsgCancelDisbursement
+	NewMsgApproveDisbursement			= types.NewMsgApproveDisbursement
+	NewMsgRejectDisbursement			= types.NewMsgRejectDisbursement
+	NewMsgRetryDisbursement				= types.NewMsgRetryDisbursement
+	NewMsgCancelFailedDisbursement		= types.NewMsgCancelFailedDisbursement
 	NewMsgCreateSellOrder				= types.NewMsgCreateSellOrder
 	NewMsgCreateBuyOrder				= types.NewMsgCreateBuyOrder
 	NewMsgSwap							= types.NewMsgSwap
+	NewMsgDisburseVesting				= types.NewMsgDisburseVesting
+	NewMsgCancelVestingDisbursement		= types.NewMsgCancelVestingDisbursement
+	NewMsgClaimVesting					= types.NewMsgClaimVesting
+	NewVestingPeriod					= types.NewVestingPeriod
+	NewLinearVestingPeriods				= types.NewLinearVestingPeriods
+	NewMsgClaimSwap						= types.NewMsgClaimSwap
+	NewMsgAttestSwap					= types.NewMsgAttestSwap
+	NewMsgRejectSwap					= types.NewMsgRejectSwap
+	NewSwapClaim						= types.NewSwapClaim
+	NewLedgerEntry						= types.NewLedgerEntry
+	NewQueryLedgerParams				= types.NewQueryLedgerParams
 
 	NewAddBuyBackLiquidityProposal = types.NewAddBuyBackLiquidityProposal
 	NewRemoveBuyBackLiquidityProposal = types.NewRemoveBuyBackLiquidityProposal
@@ -43,15 +63,39 @@ var (
 	NewTransferFromDistributionProfitsToBuyBackLiquidityProposal = types.NewTransferFromDistributionProfitsToBuyBackLiquidityProposal
 	NewTransferFromTreasuryToSwapEscrowProposal = types.NewTransferFromTreasuryToSwapEscrowProposal
 	NewTransferFromSwapEscrowToBuyBackProposal = types.NewTransferFromSwapEscrowToBuyBackProposal
+	NewSetBondingCurveProposal = types.NewSetBondingCurveProposal
+	NewLinearBondingCurve = types.NewLinearBondingCurve
+	NewExponentialBondingCurve = types.NewExponentialBondingCurve
+	NewPiecewiseBondingCurve = types.NewPiecewiseBondingCurve
+	NewCurveStep = types.NewCurveStep
+	DefaultBondingCurve = types.DefaultBondingCurve
 
 	// variable aliases
 	ModuleCdc     = types.ModuleCdc
+
+	KeyDisbursementApprovalThreshold = types.KeyDisbursementApprovalThreshold
+	KeyDisbursementLimitWindow = types.KeyDisbursementLimitWindow
+	KeyOperatorDisbursementLimit = types.KeyOperatorDisbursementLimit
+	KeyBondingCurve = types.KeyBondingCurve
+	KeyTreasuryDisbursementLimit = types.KeyTreasuryDisbursementLimit
+	KeySwapAttestationThreshold = types.KeySwapAttestationThreshold
+	KeyMaxLimitOrderDuration = types.KeyMaxLimitOrderDuration
+	KeyLedgerRetention = types.KeyLedgerRetention
+	KeyDisbursementRejectionThreshold = types.KeyDisbursementRejectionThreshold
 )
 
 type (
 	Keeper       = keeper.Keeper
 	GenesisState = types.GenesisState
 	Params       = types.Params
+	Disbursement = types.Disbursement
+	DisbursementUsage = types.DisbursementUsage
+	FailedDisbursement = types.FailedDisbursement
+	LedgerEntry = types.LedgerEntry
+	SwapClaim = types.SwapClaim
+	VestingPeriod = types.VestingPeriod
+	VestingPeriods = types.VestingPeriods
+	VestingDisbursement = types.VestingDisbursement
 
 	AddBuyBackLiquidityProposal = types.AddBuyBackLiquidityProposal
 	RemoveBuyBackLiquidityProposal = types.RemoveBuyBackLiquidityProposal
@@ -59,6 +103,9 @@ type (
 	TransferFromDistributionProfitsToBuyBackLiquidityProposal = types.TransferFromDistributionProfitsToBuyBackLiquidityProposal
 	TransferFromTreasuryToSwapEscrowProposal = types.TransferFromTreasuryToSwapEscrowProposal
 	TransferFromSwapEscrowToBuyBackProposal = types.TransferFromSwapEscrowToBuyBackProposal
+	SetBondingCurveProposal = types.SetBondingCurveProposal
+	BondingCurve = types.BondingCurve
+	CurveStep = types.CurveStep
 
 	MsgAddOperator 					= types.MsgAddOperator
 	MsgRemoveOperator 				= types.MsgRemoveOperator
@@ -67,7 +114,17 @@ type (
 	MsgDisburseFromEscrow           = types.MsgDisburseFromEscrow
 	MsgRevertFromEscrow             = types.MsgRevertFromEscrow
 	MsgCancelDisbursement			= types.MsgCancelDisbursement
+	MsgApproveDisbursement			= types.MsgApproveDisbursement
+	MsgRejectDisbursement			= types.MsgRejectDisbursement
+	MsgRetryDisbursement			= types.MsgRetryDisbursement
+	MsgCancelFailedDisbursement		= types.MsgCancelFailedDisbursement
 	MsgCreateSellOrder				= types.MsgCreateSellOrder
 	MsgCreateBuyOrder				= types.MsgCreateBuyOrder
 	MsgSwap							= types.MsgSwap
+	MsgDisburseVesting				= types.MsgDisburseVesting
+	MsgCancelVestingDisbursement	= types.MsgCancelVestingDisbursement
+	MsgClaimVesting					= types.MsgClaimVesting
+	MsgClaimSwap					= types.MsgClaimSwap
+	MsgAttestSwap					= types.MsgAttestSwap
+	MsgRejectSwap					= types.MsgRejectSwap
 )
@@ -3,22 +3,32 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strconv"
+	"time"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	denom "github.com/anathatech/project-anatha/utils"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+	orderbook "github.com/anathatech/project-anatha/x/treasury/orderbook/types"
 
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	FlagMinReceived = "min-received"
+	FlagMaxPrice = "max-price"
+	FlagDuration = "duration"
+)
+
 func GetCmdOrder(cdc *codec.Codec) *cobra.Command {
 	orderTxCmd := &cobra.Command{
 		Use:                        "order",
@@ -31,13 +41,16 @@ func GetCmdOrder(cdc *codec.Codec) *cobra.Command {
 	orderTxCmd.AddCommand(flags.PostCommands(
 		GetCmdAddSellOrder(cdc),
 		GetCmdAddBuyOrder(cdc),
+		GetCmdPlaceLimitOrder(cdc, orderbook.OrderSideBuy),
+		GetCmdPlaceLimitOrder(cdc, orderbook.OrderSideSell),
+		GetCmdCancelLimitOrder(cdc),
 	)...)
 
 	return orderTxCmd
 }
 
 func GetCmdAddSellOrder(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "sell [anatha-amount]",
 		Short: "Create a sell order",
 		Args:  cobra.ExactArgs(1),
@@ -52,7 +65,12 @@ func GetCmdAddSellOrder(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgCreateSellOrder(cliCtx.GetFromAddress(), amount)
+			minReceived, err := parseMinReceived()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSellOrder(cliCtx.GetFromAddress(), amount, minReceived)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -61,10 +79,14 @@ func GetCmdAddSellOrder(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().String(FlagMinReceived, "", "fail the order if it returns less than this usd amount")
+
+	return cmd
 }
 
 func GetCmdAddBuyOrder(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "buy [ast-amount]",
 		Short: "Create a buy order",
 		Args:  cobra.ExactArgs(1),
@@ -79,7 +101,106 @@ func GetCmdAddBuyOrder(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgCreateBuyOrder(cliCtx.GetFromAddress(), amount)
+			minReceived, err := parseMinReceived()
+			if err != nil {
+				return err
+			}
+
+			maxPrice := sdk.ZeroDec()
+			if viper.GetString(FlagMaxPrice) != "" {
+				maxPrice, err = sdk.NewDecFromStr(viper.GetString(FlagMaxPrice))
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCreateBuyOrder(cliCtx.GetFromAddress(), amount, minReceived, maxPrice)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagMinReceived, "", "fail the order if it returns less than this anatha amount")
+	cmd.Flags().String(FlagMaxPrice, "", "fail the order if it pays more than this average din per pin")
+
+	return cmd
+}
+
+func parseMinReceived() (sdk.Coins, error) {
+	if viper.GetString(FlagMinReceived) == "" {
+		return sdk.NewCoins(), nil
+	}
+
+	return denom.ParseAndConvertCoins(viper.GetString(FlagMinReceived))
+}
+
+func GetCmdPlaceLimitOrder(cdc *codec.Codec, side string) *cobra.Command {
+	use := "limit-buy [usd-amount] [price]"
+	short := "Place a limit order spending usd on anatha at the given din per pin price or lower"
+	if side == orderbook.OrderSideSell {
+		use = "limit-sell [anatha-amount] [price]"
+		short = "Place a limit order selling anatha for usd at the given din per pin price or higher"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long: short + `. As much of the order as the treasury fills within
+the price and at least as good as the order book is filled from the treasury right away, the rest of the order rests
+in the order book until it is matched, cancelled or expires.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := denom.ParseAndConvertCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := orderbook.NewMsgPlaceLimitOrder(cliCtx.GetFromAddress(), side, amount, price, viper.GetDuration(FlagDuration))
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Duration(FlagDuration, time.Hour * 24 * 7, "time after which the order expires")
+
+	return cmd
+}
+
+func GetCmdCancelLimitOrder(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-limit [order-id]",
+		Short: "Cancel a limit order and return what is left of its escrow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := orderbook.NewMsgCancelLimitOrder(cliCtx.GetFromAddress(), id)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
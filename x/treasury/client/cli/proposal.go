@@ -216,3 +216,56 @@ func GetCmdSubmitTransferFromSwapEscrowToBuyBackProposal(cdc *codec.Codec) *cobr
 
 	return cmd
 }
+
+func GetCmdSubmitSetBondingCurveProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-bonding-curve [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the treasury bonding curve",
+		Long: `Submit a proposal to set the curve the treasury sells PIN on. The price of a stage is given in units of
+$0.0000000001 per PIN. Supported curve types are "linear" (base + slope * stage), "exponential"
+(base * growth ^ stage) and "piecewise" (the price of the last step starting at or before the stage).
+Prices must never go down. The optional coins per stage replace the size of a stage.
+
+Example proposal file:
+{
+  "title": "Piecewise pricing",
+  "description": "Price the treasury stages from a table",
+  "bonding_curve": {
+    "type": "piecewise",
+    "steps": [
+      {"from_stage": "0", "price": "1"},
+      {"from_stage": "100", "price": "150"}
+    ]
+  },
+  "coins_per_stage": [{"denom": "pin", "amount": "1000000000000000"}]
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := treasuryutils.ParseBondingCurveProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewSetBondingCurveProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.BondingCurve,
+				proposal.CoinsPerStage,
+			)
+
+			msg := governance.NewMsgSubmitProposal(content, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
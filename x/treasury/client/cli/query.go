@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/csv"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+	orderbook "github.com/anathatech/project-anatha/x/treasury/orderbook/types"
+)
+
+const (
+	FlagFromHeight 	= "from-height"
+	FlagToHeight 	= "to-height"
+	FlagFormat 		= "format"
+
+	LedgerFormatJSON 	= "json"
+	LedgerFormatCSV 	= "csv"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -31,7 +42,18 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdQueryTreasury(queryRoute, cdc),
 			GetCmdOperators(queryRoute, cdc),
 			GetCmdDisbursements(queryRoute, cdc),
+			GetCmdUnapprovedDisbursements(queryRoute, cdc),
+			GetCmdDisbursementUsage(queryRoute, cdc),
+			GetCmdFailedDisbursements(queryRoute, cdc),
 			GetCmdQueryPrice(queryRoute, cdc),
+			GetCmdQueryQuote(queryRoute, cdc),
+			GetCmdQueryOrderBook(queryRoute, cdc),
+			GetCmdQueryLimitOrder(queryRoute, cdc),
+			GetCmdQueryLimitOrders(queryRoute, cdc),
+			GetCmdQueryLedger(queryRoute, cdc),
+			GetCmdQuerySwapClaims(queryRoute, cdc),
+			GetCmdQuerySwapClaim(queryRoute, cdc),
+			GetCmdQueryVestingDisbursements(queryRoute, cdc),
 			GetCmdQueryDisbursementEscrow(queryRoute, cdc),
 		)...,
 	)
@@ -129,6 +151,146 @@ func GetCmdDisbursements(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdUnapprovedDisbursements(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unapproved-disbursements",
+		Short: "Query Treasury Scheduled Disbursements still waiting for approvals",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/unapproved-disbursements", queryRoute), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve disbursements\n")
+				return nil
+			}
+
+			var out types.QueryResDisbursements
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdFailedDisbursements(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "failed-disbursements",
+		Short: "Query Treasury Disbursements that failed and wait to be retried or cancelled",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/failed-disbursements", queryRoute), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve failed disbursements\n")
+				return nil
+			}
+
+			var out types.QueryResFailedDisbursements
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdDisbursementUsage(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "disbursement-usage [operator]",
+		Short: "Query the treasury disbursement rate limit usage, optionally including an operator's usage",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/disbursement-usage", queryRoute)
+			if len(args) > 0 {
+				route = fmt.Sprintf("%s/%s", route, args[0])
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResDisbursementUsage
+			if err := cdc.UnmarshalJSON(res, &out); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQuerySwapClaims(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap-claims [operator]",
+		Short: "Query the swap claims waiting for attestations, optionally only the ones an operator has not attested or rejected yet",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/swap-claims", queryRoute)
+			if len(args) > 0 {
+				route = fmt.Sprintf("%s/%s", route, args[0])
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResSwapClaims
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQuerySwapClaim(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap-claim [reference]",
+		Short: "Query the swap claim of a reference",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/swap-claim/%s", queryRoute, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.SwapClaim
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryVestingDisbursements(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vesting-disbursements [recipient]",
+		Short: "Query the vested and unvested amounts of vesting disbursements, optionally only the ones of a recipient",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/vesting-disbursements", queryRoute)
+			if len(args) > 0 {
+				route = fmt.Sprintf("%s/%s", route, args[0])
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResVestingDisbursements
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 func GetCmdQueryPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "price [amount]",
@@ -153,6 +315,92 @@ func GetCmdQueryPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdQueryQuote(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "quote [amount]",
+		Short: "Quote a buy order for a usd amount or a sell order for an anatha amount",
+		Long: `Quote a buy order for a usd amount or a sell order for an anatha amount in the current state. Buy orders
+are filled from the buyback fund first and from the treasury for the rest.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/quote/%s", queryRoute, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var quote types.QueryResQuote
+			if err := cdc.UnmarshalJSON(res, &quote); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(quote)
+		},
+	}
+}
+
+func GetCmdQueryOrderBook(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "order-book",
+		Short: "Query the resting limit orders by price-time priority",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/order-book", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			var out orderbook.QueryResOrderBook
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryLimitOrder(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "limit-order [order-id]",
+		Short: "Query a resting limit order",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/limit-order/%s", queryRoute, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var out orderbook.LimitOrder
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryLimitOrders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "limit-orders [owner]",
+		Short: "Query the resting limit orders of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/limit-orders/%s", queryRoute, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var out orderbook.QueryResLimitOrders
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 func GetCmdQueryDisbursementEscrow(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "disbursement-escrow [reference]",
@@ -175,4 +423,104 @@ func GetCmdQueryDisbursementEscrow(queryRoute string, cdc *codec.Codec) *cobra.C
 			return cliCtx.PrintOutput(amount)
 		},
 	}
-}
\ No newline at end of file
+}
+
+func GetCmdQueryLedger(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ledger",
+		Short: "Export the recorded movements of treasury funds",
+		Long: `Export every recorded movement of funds from or to the treasury module accounts between two heights, inclusive.
+Leave --to-height unset to export up to the latest block. Entries older than the ledger retention param are pruned.
+
+Example:
+$ anathacli query treasury ledger --from-height 1000 --to-height 2000 --format csv > ledger.csv`,
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+
+			if format != LedgerFormatJSON && format != LedgerFormatCSV {
+				return fmt.Errorf("invalid format %s, expected %s or %s", format, LedgerFormatJSON, LedgerFormatCSV)
+			}
+
+			// the ledger is queried page by page, a single query returns at most QueryLedgerMaxLimit entries. Every page
+			// is queried at the height of the first one so that entries recorded or pruned meanwhile don't shift the pages.
+			out := types.QueryResLedger{}
+			fromID := uint64(0)
+			for {
+				bz, err := cdc.MarshalJSON(types.NewQueryLedgerParams(fromHeight, fromID, toHeight, types.QueryLedgerMaxLimit))
+				if err != nil {
+					return err
+				}
+
+				res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/ledger", queryRoute), bz)
+				if err != nil {
+					return err
+				}
+
+				if cliCtx.Height == 0 {
+					cliCtx = cliCtx.WithHeight(height)
+				}
+
+				if toHeight == 0 || toHeight > height {
+					toHeight = height
+				}
+
+				var entries types.QueryResLedger
+				cdc.MustUnmarshalJSON(res, &entries)
+
+				out = append(out, entries...)
+
+				if len(entries) < types.QueryLedgerMaxLimit {
+					break
+				}
+
+				last := entries[len(entries) - 1]
+				fromHeight, fromID = last.Height, last.ID + 1
+			}
+
+			if format == LedgerFormatJSON {
+				cliCtx.OutputFormat = "json"
+				return cliCtx.PrintOutput(out)
+			}
+
+			w := csv.NewWriter(cmd.OutOrStdout())
+
+			err = w.Write(types.LedgerEntry{}.CSVHeader())
+			if err != nil {
+				return err
+			}
+
+			for _, entry := range out {
+				err = w.Write(entry.CSVRecord())
+				if err != nil {
+					return err
+				}
+			}
+
+			w.Flush()
+
+			return w.Error()
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "first block height to export")
+	cmd.Flags().Int64(FlagToHeight, 0, "last block height to export, the latest block if unset")
+	cmd.Flags().String(FlagFormat, LedgerFormatJSON, "export format, json or csv")
+
+	return cmd
+}
@@ -30,11 +30,20 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdOperator(cdc),
 		GetCmdOrder(cdc),
 		GetCmdDisburse(cdc),
+		GetCmdDisburseVesting(cdc),
+		GetCmdCancelVestingDisbursement(cdc),
+		GetCmdClaimVesting(cdc),
 		GetCmdCancelDisbursement(cdc),
+		GetCmdApproveDisbursement(cdc),
+		GetCmdRejectDisbursement(cdc),
+		GetCmdRetryDisbursement(cdc),
+		GetCmdCancelFailedDisbursement(cdc),
 		GetCmdDisburseToEscrow(cdc),
 		GetCmdDisburseFromEscrow(cdc),
 		GetCmdRevertFromEscrow(cdc),
-		GetCmdSwap(cdc),
+		GetCmdClaimSwap(cdc),
+		GetCmdAttestSwap(cdc),
+		GetCmdRejectSwap(cdc),
 	)...)
 
 	return treasuryTxCmd
@@ -74,10 +83,37 @@ func GetCmdDisburse(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
-func GetCmdSwap(cdc *codec.Codec) *cobra.Command {
+func GetCmdClaimSwap(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "swap [recipient] [amount] [reference]",
-		Short: "Swap treasury funds",
+		Use:   "claim-swap [amount] [reference]",
+		Short: "Claim tokens swapped from the legacy chain, paid out once enough operators attested the claim",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := denom.ParseAndConvertCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimSwap(cliCtx.GetFromAddress(), amount, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdAttestSwap(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "attest-swap [recipient] [amount] [reference]",
+		Short: "Attest a swap claim, the recipient and amount have to match the claim",
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -95,9 +131,7 @@ func GetCmdSwap(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			reference := args[2]
-
-			msg := types.NewMsgSwap(cliCtx.GetFromAddress(), recipient, amount, reference)
+			msg := types.NewMsgAttestSwap(cliCtx.GetFromAddress(), recipient, amount, args[2])
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -108,10 +142,32 @@ func GetCmdSwap(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdRejectSwap(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reject-swap [reference]",
+		Short: "Reject a swap claim",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRejectSwap(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 func GetCmdCancelDisbursement(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "cancel-disbursement [recipient] [scheduled]",
-		Short: "Cancel scheduled distribution",
+		Short: "Cancel scheduled distribution, managers can cancel approved distributions until they are executed",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -135,6 +191,114 @@ func GetCmdCancelDisbursement(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdApproveDisbursement(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "approve-disbursement [recipient] [scheduled]",
+		Short: "Approve scheduled distribution",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveDisbursement(cliCtx.GetFromAddress(), recipient, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRejectDisbursement(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reject-disbursement [recipient] [scheduled]",
+		Short: "Reject scheduled distribution",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRejectDisbursement(cliCtx.GetFromAddress(), recipient, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRetryDisbursement(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "retry-disbursement [recipient] [scheduled]",
+		Short: "Retry failed distribution",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRetryDisbursement(cliCtx.GetFromAddress(), recipient, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCancelFailedDisbursement(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-failed-disbursement [recipient] [scheduled]",
+		Short: "Cancel failed distribution and release its reference",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelFailedDisbursement(cliCtx.GetFromAddress(), recipient, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 func GetCmdDisburseToEscrow(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "disburse-to-escrow [amount] [reference]",
@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	denom "github.com/anathatech/project-anatha/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	FlagCliff = "cliff"
+	FlagVestingDuration = "vesting-duration"
+	FlagPeriods = "periods"
+	FlagSchedule = "schedule"
+)
+
+// vestingSchedulePeriod is a period of a custom vesting schedule file, the length is relative to the previous period.
+type vestingSchedulePeriod struct {
+	Length string `json:"length"`
+	Amount string `json:"amount"`
+}
+
+func GetCmdDisburseVesting(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disburse-vesting [recipient] [amount] [reference]",
+		Short: "Disburse treasury funds vesting over a schedule",
+		Long: `Disburse treasury funds vesting over a schedule. By default the amount vests linearly over --vesting-duration
+in --periods equal periods, with nothing released before --cliff. A custom schedule can be given instead with --schedule,
+a JSON file listing the periods, the amount argument is then ignored:
+
+[{"length": "720h", "amount": "1000usd"}, {"length": "2160h", "amount": "3000usd"}]`,
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var periods types.VestingPeriods
+
+			if viper.GetString(FlagSchedule) != "" {
+				periods, err = parseVestingSchedule(viper.GetString(FlagSchedule))
+				if err != nil {
+					return err
+				}
+			} else {
+				amount, err := denom.ParseAndConvertCoins(args[1])
+				if err != nil {
+					return err
+				}
+
+				periods = types.NewLinearVestingPeriods(amount, viper.GetDuration(FlagCliff), viper.GetDuration(FlagVestingDuration), viper.GetInt64(FlagPeriods))
+			}
+
+			reference := args[2]
+
+			msg := types.NewMsgDisburseVesting(cliCtx.GetFromAddress(), recipient, reference, periods)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Duration(FlagCliff, 0, "time before which nothing is released")
+	cmd.Flags().Duration(FlagVestingDuration, time.Hour * 24 * 365, "time over which the whole amount vests")
+	cmd.Flags().Int64(FlagPeriods, 12, "number of equal periods the amount is split into")
+	cmd.Flags().String(FlagSchedule, "", "JSON file with a custom vesting schedule")
+
+	return cmd
+}
+
+func parseVestingSchedule(path string) (types.VestingPeriods, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedule []vestingSchedulePeriod
+	if err := json.Unmarshal(contents, &schedule); err != nil {
+		return nil, err
+	}
+
+	var periods types.VestingPeriods
+	for i, period := range schedule {
+		length, err := time.ParseDuration(period.Length)
+		if err != nil {
+			return nil, fmt.Errorf("period %d: %s", i, err)
+		}
+
+		amount, err := denom.ParseAndConvertCoins(period.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("period %d: %s", i, err)
+		}
+
+		periods = append(periods, types.NewVestingPeriod(length, amount))
+	}
+
+	return periods, nil
+}
+
+func GetCmdCancelVestingDisbursement(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-vesting-disbursement [recipient] [reference]",
+		Short: "Cancel the unvested remainder of a vesting disbursement",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelVestingDisbursement(cliCtx.GetFromAddress(), recipient, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdClaimVesting(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-vesting [reference]",
+		Short: "Claim the vested and approved tranches of a vesting disbursement",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgClaimVesting(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
 	"io/ioutil"
 )
 
@@ -26,3 +27,25 @@ func ParseGenericTreasuryProposalJSON(cdc *codec.Codec, proposalFile string) (Ge
 
 	return proposal, nil
 }
+
+type BondingCurveProposalJSON struct {
+	Title       	string   			`json:"title" yaml:"title"`
+	Description 	string   			`json:"description" yaml:"description"`
+	BondingCurve 	types.BondingCurve 	`json:"bonding_curve" yaml:"bonding_curve"`
+	CoinsPerStage 	sdk.Coins 			`json:"coins_per_stage" yaml:"coins_per_stage"`
+}
+
+func ParseBondingCurveProposalJSON(cdc *codec.Codec, proposalFile string) (BondingCurveProposalJSON, error) {
+	proposal := BondingCurveProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
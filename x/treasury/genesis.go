@@ -2,12 +2,22 @@ package treasury
 
 import (
 	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+	"github.com/anathatech/project-anatha/x/treasury/orderbook"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 
-func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) []abci.ValidatorUpdate {
+func InitGenesis(ctx sdk.Context, k Keeper, orderBookKeeper orderbook.Keeper, data GenesisState) []abci.ValidatorUpdate {
+	// the ledger goes first so the genesis supply is recorded after the imported entries
+	for _, entry := range data.Ledger {
+		k.SetLedgerEntry(ctx, entry)
+	}
+
+	if data.NextLedgerEntryID > 0 {
+		k.SetNextLedgerEntryID(ctx, data.NextLedgerEntryID)
+	}
+
 	for _, record := range data.Operators {
 		k.AddOperator(ctx, record)
 	}
@@ -46,10 +56,28 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) []abci.ValidatorU
 		k.SetDisbursementReferenceAmount(ctx, disbursementReference.Reference, disbursementReference.Amount)
 	}
 
+	for _, usage := range data.DisbursementUsage {
+		k.SetDisbursementUsageRecord(ctx, usage)
+	}
+
+	for _, failed := range data.FailedDisbursements {
+		k.SetFailedDisbursement(ctx, failed)
+	}
+
+	orderbook.InitGenesis(ctx, orderBookKeeper, data.OrderBook)
+
+	for _, claim := range data.SwapClaims {
+		k.SetSwapClaim(ctx, claim)
+	}
+
+	for _, vesting := range data.VestingDisbursements {
+		k.SetVestingDisbursement(ctx, vesting)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
-func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
+func ExportGenesis(ctx sdk.Context, k Keeper, orderBookKeeper orderbook.Keeper) (data GenesisState) {
 	treasury := k.GetTreasury(ctx)
 	params := k.GetParams(ctx)
 	operators := k.GetOperators(ctx)
@@ -62,5 +90,23 @@ func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
 		return false
 	})
 
-	return NewGenesisState(treasury, params, operators, disbursements, disbursementReferences)
+	var disbursementUsage []types.DisbursementUsage
+	k.IterateDisbursementUsage(ctx, func(usage types.DisbursementUsage) (stop bool) {
+		disbursementUsage = append(disbursementUsage, usage)
+
+		return false
+	})
+
+	failedDisbursements := k.GetFailedDisbursements(ctx)
+
+	orderBook := orderbook.ExportGenesis(ctx, orderBookKeeper)
+
+	// entries left over from pruning are not exported
+	ledger := k.GetLedger(ctx, k.LedgerRetentionStart(ctx), 0)
+	nextLedgerEntryID := k.GetNextLedgerEntryID(ctx)
+
+	swapClaims := k.GetSwapClaims(ctx)
+	vestingDisbursements := k.GetVestingDisbursements(ctx)
+
+	return NewGenesisState(treasury, params, operators, disbursements, disbursementReferences, disbursementUsage, failedDisbursements, orderBook, ledger, nextLedgerEntryID, swapClaims, vestingDisbursements)
 }
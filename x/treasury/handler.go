@@ -2,14 +2,18 @@ package treasury
 
 import (
 	"fmt"
+	"github.com/anathatech/project-anatha/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+	"github.com/anathatech/project-anatha/x/treasury/orderbook"
 	"time"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-func NewHandler(k Keeper) sdk.Handler {
+func NewHandler(k Keeper, orderBookKeeper orderbook.Keeper) sdk.Handler {
+	orderBookHandler := orderbook.NewHandler(orderBookKeeper)
+
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		switch msg := msg.(type) {
@@ -35,15 +39,48 @@ func NewHandler(k Keeper) sdk.Handler {
 		case MsgCancelDisbursement:
 			return handleMsgCancelDisbursement(ctx, k, msg)
 
+		case MsgApproveDisbursement:
+			return handleMsgApproveDisbursement(ctx, k, msg)
+
+		case MsgRejectDisbursement:
+			return handleMsgRejectDisbursement(ctx, k, msg)
+
+		case MsgRetryDisbursement:
+			return handleMsgRetryDisbursement(ctx, k, msg)
+
+		case MsgCancelFailedDisbursement:
+			return handleMsgCancelFailedDisbursement(ctx, k, msg)
+
 		case MsgCreateSellOrder:
 			return handleMsgCreateSellOrder(ctx, k, msg)
 
 		case MsgCreateBuyOrder:
 			return handleMsgCreateBuyOrder(ctx, k, msg)
 
+		case MsgDisburseVesting:
+			return handleMsgDisburseVesting(ctx, k, msg)
+
+		case MsgCancelVestingDisbursement:
+			return handleMsgCancelVestingDisbursement(ctx, k, msg)
+
+		case MsgClaimVesting:
+			return handleMsgClaimVesting(ctx, k, msg)
+
 		case MsgSwap:
 			return handleMsgSwap(ctx, k, msg)
 
+		case MsgClaimSwap:
+			return handleMsgClaimSwap(ctx, k, msg)
+
+		case MsgAttestSwap:
+			return handleMsgAttestSwap(ctx, k, msg)
+
+		case MsgRejectSwap:
+			return handleMsgRejectSwap(ctx, k, msg)
+
+		case orderbook.MsgPlaceLimitOrder, orderbook.MsgCancelLimitOrder:
+			return orderBookHandler(ctx, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName,  msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -72,6 +109,9 @@ func NewTreasuryProposalHandler(k Keeper) govtypes.Handler {
 			case TransferFromSwapEscrowToBuyBackProposal:
 				return handleTransferSwapEscrowToBuyBackProposal(ctx, k, c)
 
+			case SetBondingCurveProposal:
+				return handleSetBondingCurveProposal(ctx, k, c)
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized treasury proposal content type: %T", c)
 		}
@@ -79,7 +119,7 @@ func NewTreasuryProposalHandler(k Keeper) govtypes.Handler {
 }
 
 func handleAddBuyBackLiquidityProposal(ctx sdk.Context, k Keeper, p AddBuyBackLiquidityProposal) error {
-	err := k.MintCoinsToBuyBackLiquidityFund(ctx, p.Amount)
+	err := k.MintCoinsToBuyBackLiquidityFund(ctx, p.Title, p.Amount)
 	if err != nil {
 		return err
 	}
@@ -98,7 +138,7 @@ func handleAddBuyBackLiquidityProposal(ctx sdk.Context, k Keeper, p AddBuyBackLi
 }
 
 func handleRemoveBuyBackLiquidityProposal(ctx sdk.Context, k Keeper, p RemoveBuyBackLiquidityProposal) error {
-	err := k.BurnCoinsFromBuyBackLiquidityFund(ctx, p.Amount)
+	err := k.BurnCoinsFromBuyBackLiquidityFund(ctx, p.Title, p.Amount)
 	if err != nil {
 		return err
 	}
@@ -117,7 +157,7 @@ func handleRemoveBuyBackLiquidityProposal(ctx sdk.Context, k Keeper, p RemoveBuy
 }
 
 func handleBurnDistributionProfitsProposal(ctx sdk.Context, k Keeper, p BurnDistributionProfitsProposal) error {
-	err := k.BurnFromDistributionProfits(ctx, p.Amount)
+	err := k.BurnFromDistributionProfits(ctx, p.Title, p.Amount)
 	if err != nil {
 		return err
 	}
@@ -136,7 +176,7 @@ func handleBurnDistributionProfitsProposal(ctx sdk.Context, k Keeper, p BurnDist
 }
 
 func handleTransferFromDistributionProfitsToBuyBackLiquidityProposal(ctx sdk.Context, k Keeper, p TransferFromDistributionProfitsToBuyBackLiquidityProposal) error {
-	err := k.TransferFromDistributionProfitsToBuyBackLiquidity(ctx, p.Amount)
+	err := k.TransferFromDistributionProfitsToBuyBackLiquidity(ctx, p.Title, p.Amount)
 	if err != nil {
 		return err
 	}
@@ -155,7 +195,7 @@ func handleTransferFromDistributionProfitsToBuyBackLiquidityProposal(ctx sdk.Con
 }
 
 func handleTransferFromTreasuryToSwapEscrowProposal(ctx sdk.Context, k Keeper, p TransferFromTreasuryToSwapEscrowProposal) error {
-	err := k.TransferFromTreasuryToSwapEscrow(ctx, p.Amount)
+	err := k.TransferFromTreasuryToSwapEscrow(ctx, p.Title, p.Amount)
 	if err != nil {
 		return err
 	}
@@ -174,7 +214,7 @@ func handleTransferFromTreasuryToSwapEscrowProposal(ctx sdk.Context, k Keeper, p
 }
 
 func handleTransferSwapEscrowToBuyBackProposal(ctx sdk.Context, k Keeper, p TransferFromSwapEscrowToBuyBackProposal) error {
-	err := k.TransferFromSwapEscrowToBuyBack(ctx, p.Amount)
+	err := k.TransferFromSwapEscrowToBuyBack(ctx, p.Title, p.Amount)
 	if err != nil {
 		return err
 	}
@@ -260,8 +300,64 @@ func handleMsgCancelDisbursement(ctx sdk.Context, k Keeper, msg MsgCancelDisburs
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgApproveDisbursement(ctx sdk.Context, k Keeper, msg MsgApproveDisbursement) (*sdk.Result, error) {
+	scheduledFor, err := time.Parse("2006-01-02T15:04:05.99999999999Z", msg.ScheduledFor)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidTime, msg.ScheduledFor)
+	}
+
+	err = k.HandleApproveDisbursement(ctx, msg.Sender, msg.Recipient, scheduledFor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRejectDisbursement(ctx sdk.Context, k Keeper, msg MsgRejectDisbursement) (*sdk.Result, error) {
+	scheduledFor, err := time.Parse("2006-01-02T15:04:05.99999999999Z", msg.ScheduledFor)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidTime, msg.ScheduledFor)
+	}
+
+	err = k.HandleRejectDisbursement(ctx, msg.Sender, msg.Recipient, scheduledFor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRetryDisbursement(ctx sdk.Context, k Keeper, msg MsgRetryDisbursement) (*sdk.Result, error) {
+	scheduledFor, err := time.Parse("2006-01-02T15:04:05.99999999999Z", msg.ScheduledFor)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidTime, msg.ScheduledFor)
+	}
+
+	err = k.HandleRetryDisbursement(ctx, msg.Manager, msg.Recipient, scheduledFor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelFailedDisbursement(ctx sdk.Context, k Keeper, msg MsgCancelFailedDisbursement) (*sdk.Result, error) {
+	scheduledFor, err := time.Parse("2006-01-02T15:04:05.99999999999Z", msg.ScheduledFor)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidTime, msg.ScheduledFor)
+	}
+
+	err = k.HandleCancelFailedDisbursement(ctx, msg.Manager, msg.Recipient, scheduledFor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgCreateSellOrder(ctx sdk.Context, k Keeper, msg MsgCreateSellOrder) (*sdk.Result, error) {
-	err := k.HandleCreateSellOrder(ctx, msg.Seller, msg.Amount)
+	err := k.HandleCreateSellOrder(ctx, msg.Seller, msg.Amount, msg.MinReceived)
 	if err != nil {
 		return nil, err
 	}
@@ -270,7 +366,34 @@ func handleMsgCreateSellOrder(ctx sdk.Context, k Keeper, msg MsgCreateSellOrder)
 }
 
 func handleMsgCreateBuyOrder(ctx sdk.Context, k Keeper, msg MsgCreateBuyOrder) (*sdk.Result, error) {
-	err := k.HandleCreateBuyOrder(ctx, msg.Buyer, msg.Amount)
+	err := k.HandleCreateBuyOrder(ctx, msg.Buyer, msg.Amount, msg.MinReceived, msg.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgDisburseVesting(ctx sdk.Context, k Keeper, msg MsgDisburseVesting) (*sdk.Result, error) {
+	err := k.HandleDisburseVesting(ctx, msg.Operator, msg.Recipient, msg.Reference, msg.Periods)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelVestingDisbursement(ctx sdk.Context, k Keeper, msg MsgCancelVestingDisbursement) (*sdk.Result, error) {
+	err := k.HandleCancelVestingDisbursement(ctx, msg.Manager, msg.Recipient, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgClaimVesting(ctx sdk.Context, k Keeper, msg MsgClaimVesting) (*sdk.Result, error) {
+	err := k.HandleClaimVesting(ctx, msg.Recipient, msg.Reference)
 	if err != nil {
 		return nil, err
 	}
@@ -279,10 +402,59 @@ func handleMsgCreateBuyOrder(ctx sdk.Context, k Keeper, msg MsgCreateBuyOrder) (
 }
 
 func handleMsgSwap(ctx sdk.Context, k Keeper, msg MsgSwap) (*sdk.Result, error) {
-	err := k.HandleSwap(ctx, msg.Operator, msg.Recipient, msg.Amount, msg.Reference)
+	return nil, sdkerrors.Wrap(types.ErrSwapDisabled, "use claim-swap and let the operators attest the claim")
+}
+
+func handleMsgClaimSwap(ctx sdk.Context, k Keeper, msg MsgClaimSwap) (*sdk.Result, error) {
+	err := k.HandleClaimSwap(ctx, msg.Recipient, msg.Amount, msg.Reference)
 	if err != nil {
 		return nil, err
 	}
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgAttestSwap(ctx sdk.Context, k Keeper, msg MsgAttestSwap) (*sdk.Result, error) {
+	err := k.HandleAttestSwap(ctx, msg.Operator, msg.Recipient, msg.Amount, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRejectSwap(ctx sdk.Context, k Keeper, msg MsgRejectSwap) (*sdk.Result, error) {
+	err := k.HandleRejectSwap(ctx, msg.Operator, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleSetBondingCurveProposal(ctx sdk.Context, k Keeper, p SetBondingCurveProposal) error {
+	if ! p.CoinsPerStage.Empty() {
+		targetSupply := k.GetTreasury(ctx).TargetSupply.AmountOf(config.DefaultDenom)
+
+		if err := types.ValidateStageCount(targetSupply, p.CoinsPerStage.AmountOf(config.DefaultDenom)); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidBondingCurve, err.Error())
+		}
+
+		k.SetCoinsPerStage(ctx, p.CoinsPerStage)
+	}
+
+	k.SetBondingCurve(ctx, p.BondingCurve)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetBondingCurve,
+			sdk.NewAttribute(types.AttributeKeyTitle, p.Title),
+			sdk.NewAttribute(types.AttributeKeyDescription, p.Description),
+			sdk.NewAttribute(types.AttributeKeyBondingCurve, p.BondingCurve.String()),
+			sdk.NewAttribute(types.AttributeKeyCoinsPerStage, p.CoinsPerStage.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"github.com/anathatech/project-anatha/config"
 	"time"
 
@@ -17,23 +18,29 @@ func (k Keeper) HandleDisburse(ctx sdk.Context, operator sdk.AccAddress, recipie
 		return types.ErrDuplicateReference
 	}
 
-	scheduledFor := ctx.BlockTime()
-
-	if dinAmount.IsAnyGTE(k.RiskAssessmentAmount(ctx)) {
-		scheduledFor = scheduledFor.Add(k.RiskAssessmentDuration(ctx))
+	delay, requiredApprovals, usageRecordedAt, err := k.scheduleDisbursement(ctx, operator, dinAmount)
+	if err != nil {
+		return err
 	}
 
+	scheduledFor := ctx.BlockTime().Add(delay)
+
 	for k.HasDisbursementInQueue(ctx, recipient, scheduledFor) {
 		scheduledFor = scheduledFor.Add(time.Millisecond)
 	}
 
-	k.InsertDisbursementQueue(ctx, types.NewDisbursement(
+	disbursement := types.NewDisbursement(
 		operator,
 		recipient,
 		dinAmount,
 		scheduledFor,
 		reference,
-	))
+		requiredApprovals,
+		k.DisbursementRejectionThreshold(ctx),
+	)
+	disbursement.UsageRecordedAt = usageRecordedAt
+
+	k.InsertDisbursementQueue(ctx, disbursement)
 
 	k.SetDisbursementReferenceAmount(ctx, reference, sdk.ZeroInt())
 
@@ -44,6 +51,7 @@ func (k Keeper) HandleDisburse(ctx sdk.Context, operator sdk.AccAddress, recipie
 			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
 			sdk.NewAttribute(types.AttributeKeyAmount, dinAmount.String()),
 			sdk.NewAttribute(types.AttributeKeyReference, reference),
+			sdk.NewAttribute(types.AttributeKeyRequiredApprovals, fmt.Sprintf("%d", requiredApprovals)),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -55,6 +63,24 @@ func (k Keeper) HandleDisburse(ctx sdk.Context, operator sdk.AccAddress, recipie
 	return nil
 }
 
+// scheduleDisbursement returns the delay and the approvals needed by a disbursement of the given amount. Large
+// disbursements and disbursements over the rate limit are delayed and need to be approved by multiple managers or
+// operators, others are approved by the operator alone and their usage is recorded.
+func (k Keeper) scheduleDisbursement(ctx sdk.Context, operator sdk.AccAddress, dinAmount sdk.Coins) (time.Duration, uint64, time.Time, error) {
+	if dinAmount.IsAnyGTE(k.RiskAssessmentAmount(ctx)) || k.CheckDisbursementLimits(ctx, operator, dinAmount) != nil {
+		if err := k.CheckDisbursementThresholds(ctx, k.CountDisbursementVoters(ctx)); err != nil {
+			return 0, 0, time.Time{}, err
+		}
+
+		return k.RiskAssessmentDuration(ctx), k.DisbursementApprovalThreshold(ctx), time.Time{}, nil
+	}
+
+	// the usage of a disbursement approved by the operator alone is released if it is not paid out
+	k.RecordDisbursementUsage(ctx, operator, dinAmount)
+
+	return 0, 1, ctx.BlockTime(), nil
+}
+
 func (k Keeper) HandleDisburseToEscrow(ctx sdk.Context, operator sdk.AccAddress, dinAmount sdk.Coins, reference string) error {
 	if ! k.IsOperator(ctx, operator) {
 		return types.ErrNotOperator
@@ -68,13 +94,21 @@ func (k Keeper) HandleDisburseToEscrow(ctx sdk.Context, operator sdk.AccAddress,
 		return types.ErrEscrowDistributionAmountExceeded
 	}
 
+	// escrow disbursements can't fall back to the risk assessment delay
+	err := k.CheckDisbursementLimits(ctx, operator, dinAmount)
+	if err != nil {
+		return err
+	}
+
 	totalPinAmount, fromBuyBack, fromTreasury := k.CalculatePinAmountExtended(ctx, dinAmount)
 
-	err := k.DisburseFundsToEscrow(ctx, reference, dinAmount, fromBuyBack, fromTreasury)
+	err = k.DisburseFundsToEscrow(ctx, reference, dinAmount, fromBuyBack, fromTreasury)
 	if err != nil {
 		return err
 	}
 
+	k.RecordDisbursementUsage(ctx, operator, dinAmount)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeDisburseToEscrow,
@@ -150,7 +184,7 @@ func (k Keeper) HandleRevertFromEscrow(ctx sdk.Context, operator sdk.AccAddress,
 		return types.ErrEscrowRevertAmountTooBig
 	}
 
-	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.TreasuryEscrowModuleName, types.BuyBackFundModuleName, amount)
+	err := k.sendFromModuleToModule(ctx, types.LedgerCategoryEscrow, reference, types.TreasuryEscrowModuleName, types.BuyBackFundModuleName, amount)
 	if err != nil {
 		return err
 	}
@@ -176,16 +210,22 @@ func (k Keeper) HandleRevertFromEscrow(ctx sdk.Context, operator sdk.AccAddress,
 	return nil
 }
 
+// HandleCancelDisbursement removes a scheduled disbursement. Any single manager may cancel, even after the disbursement
+// collected its approvals: the risk assessment delay exists so that managers can stop a payout before it is executed.
+// Operators can only vote against a disbursement with HandleRejectDisbursement.
 func (k Keeper) HandleCancelDisbursement(ctx sdk.Context, manager sdk.AccAddress, recipient sdk.AccAddress, scheduledFor time.Time) error {
 	if ! k.IsManager(ctx, manager) {
 		return types.ErrNotManager
 	}
 
-	if ! k.HasDisbursementInQueue(ctx, recipient, scheduledFor) {
+	disbursement, found := k.GetDisbursement(ctx, recipient, scheduledFor)
+	if ! found {
 		return types.ErrDisbursementNotScheduled
 	}
 
 	k.RemoveFromDisbursementQueue(ctx, recipient, scheduledFor)
+	k.ReleaseDisbursementUsage(ctx, disbursement)
+	k.dropVestingTranche(ctx, disbursement)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -203,20 +243,157 @@ func (k Keeper) HandleCancelDisbursement(ctx sdk.Context, manager sdk.AccAddress
 	return nil
 }
 
-func (k Keeper) DisburseFunds(ctx sdk.Context, operator sdk.AccAddress, recipient sdk.AccAddress, dinAmount sdk.Coins, fromBuyBack sdk.Coins, fromTreasury sdk.Coins) error {
+// HandleApproveDisbursement records the approval of a manager or operator. The disbursement is executed once it has
+// enough approvals and its scheduled time has passed. Approving a tranche of a vesting disbursement approves all of its
+// tranches.
+func (k Keeper) HandleApproveDisbursement(ctx sdk.Context, approver sdk.AccAddress, recipient sdk.AccAddress, scheduledFor time.Time) error {
+	disbursement, err := k.getDisbursementForVote(ctx, approver, recipient, scheduledFor)
+	if err != nil {
+		return err
+	}
+
+	if disbursement.IsApproved() {
+		return types.ErrDisbursementApproved
+	}
+
+	disbursement.Approvals = append(disbursement.Approvals, approver)
+
+	k.InsertDisbursementQueue(ctx, disbursement)
+	k.approveVestingTranches(ctx, approver, disbursement)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApproveDisbursement,
+			sdk.NewAttribute(types.AttributeKeyScheduledFor, scheduledFor.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyApprovals, fmt.Sprintf("%d", len(disbursement.Approvals))),
+			sdk.NewAttribute(types.AttributeKeyRequiredApprovals, fmt.Sprintf("%d", disbursement.RequiredApprovals)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, approver.String()),
+		),
+	})
+
+	return nil
+}
+
+// HandleRejectDisbursement records the rejection of a manager or operator. The disbursement is removed from the queue
+// once it collected the rejections it requires.
+func (k Keeper) HandleRejectDisbursement(ctx sdk.Context, rejecter sdk.AccAddress, recipient sdk.AccAddress, scheduledFor time.Time) error {
+	disbursement, err := k.getDisbursementForVote(ctx, rejecter, recipient, scheduledFor)
+	if err != nil {
+		return err
+	}
+
+	disbursement.Rejections = append(disbursement.Rejections, rejecter)
+
+	if disbursement.IsRejected() {
+		k.RemoveFromDisbursementQueue(ctx, recipient, scheduledFor)
+		k.ReleaseDisbursementUsage(ctx, disbursement)
+		k.dropVestingTranche(ctx, disbursement)
+	} else {
+		k.InsertDisbursementQueue(ctx, disbursement)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRejectDisbursement,
+			sdk.NewAttribute(types.AttributeKeyScheduledFor, scheduledFor.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyRejections, fmt.Sprintf("%d", len(disbursement.Rejections))),
+			sdk.NewAttribute(types.AttributeKeyRequiredRejections, fmt.Sprintf("%d", disbursement.RequiredRejections)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, rejecter.String()),
+		),
+	})
+
+	return nil
+}
+
+// DisbursementApprovalDeadline returns the time after which a disbursement still waiting for approvals is dropped: one
+// risk assessment duration after it was scheduled for, twice the risk assessment duration after it was created.
+func (k Keeper) DisbursementApprovalDeadline(ctx sdk.Context, disbursement types.Disbursement) time.Time {
+	return disbursement.ScheduledFor.Add(k.RiskAssessmentDuration(ctx))
+}
+
+// ExpireDisbursement removes a disbursement that missed its approval deadline from the queue and releases its usage. The
+// reference can be used again, unless the disbursement is a vesting tranche: the tranche is dropped and the vesting
+// disbursement keeps its reference.
+func (k Keeper) ExpireDisbursement(ctx sdk.Context, disbursement types.Disbursement) {
+	k.RemoveFromDisbursementQueue(ctx, disbursement.Recipient, disbursement.ScheduledFor)
+	k.ReleaseDisbursementUsage(ctx, disbursement)
+
+	if _, found := k.GetVestingDisbursement(ctx, disbursement.Recipient, disbursement.Reference); found {
+		k.dropVestingTranche(ctx, disbursement)
+	} else {
+		k.RemoveDisbursementReferenceAmount(ctx, disbursement.Reference)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExpireDisbursement,
+			sdk.NewAttribute(types.AttributeKeyScheduledFor, disbursement.ScheduledFor.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, disbursement.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyReference, disbursement.Reference),
+			sdk.NewAttribute(types.AttributeKeyApprovals, fmt.Sprintf("%d", len(disbursement.Approvals))),
+			sdk.NewAttribute(types.AttributeKeyRequiredApprovals, fmt.Sprintf("%d", disbursement.RequiredApprovals)),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+}
+
+func (k Keeper) getDisbursementForVote(ctx sdk.Context, voter sdk.AccAddress, recipient sdk.AccAddress, scheduledFor time.Time) (types.Disbursement, error) {
+	if ! k.IsManager(ctx, voter) && ! k.IsOperator(ctx, voter) {
+		return types.Disbursement{}, types.ErrNotApprover
+	}
+
+	disbursement, found := k.GetDisbursement(ctx, recipient, scheduledFor)
+	if ! found {
+		return types.Disbursement{}, types.ErrDisbursementNotScheduled
+	}
+
+	if disbursement.HasVoted(voter) {
+		return types.Disbursement{}, types.ErrAlreadyVoted
+	}
+
+	return disbursement, nil
+}
+
+// ExecuteDisbursement pays out a scheduled disbursement at the current price. Nothing is changed if it fails.
+func (k Keeper) ExecuteDisbursement(ctx sdk.Context, operator sdk.AccAddress, disbursement types.Disbursement) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	_, fromBuyBack, fromTreasury := k.CalculatePinAmountExtended(cacheCtx, disbursement.Amount)
+
+	err := k.DisburseFunds(cacheCtx, types.LedgerCategoryDisbursement, disbursement.Reference, operator, disbursement.Recipient, disbursement.Amount, fromBuyBack, fromTreasury)
+	if err != nil {
+		return err
+	}
+
+	writeCache()
+
+	return nil
+}
+
+func (k Keeper) DisburseFunds(ctx sdk.Context, category string, reference string, operator sdk.AccAddress, recipient sdk.AccAddress, dinAmount sdk.Coins, fromBuyBack sdk.Coins, fromTreasury sdk.Coins) error {
 	if ! operator.Empty() && ! k.IsOperator(ctx, operator) {
 		return types.ErrNotOperator
 	}
 
 	if ! fromBuyBack.IsZero() {
-		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.BuyBackFundModuleName, recipient, fromBuyBack)
+		err := k.SendFromModuleToAccount(ctx, category, reference, types.BuyBackFundModuleName, recipient, fromBuyBack)
 		if err != nil {
 			return err
 		}
 	}
 
 	if ! fromTreasury.IsZero() {
-		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, fromTreasury)
+		err := k.SendFromModuleToAccount(ctx, category, reference, types.ModuleName, recipient, fromTreasury)
 		if err != nil {
 			return err
 		}
@@ -232,14 +409,14 @@ func (k Keeper) DisburseFunds(ctx sdk.Context, operator sdk.AccAddress, recipien
 
 func (k Keeper) DisburseFundsToEscrow(ctx sdk.Context, reference string, dinAmount sdk.Coins, fromBuyBack sdk.Coins, fromTreasury sdk.Coins) error {
 	if ! fromBuyBack.IsZero() {
-		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.BuyBackFundModuleName, types.TreasuryEscrowModuleName, fromBuyBack)
+		err := k.sendFromModuleToModule(ctx, types.LedgerCategoryEscrow, reference, types.BuyBackFundModuleName, types.TreasuryEscrowModuleName, fromBuyBack)
 		if err != nil {
 			return err
 		}
 	}
 
 	if ! fromTreasury.IsZero() {
-		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.TreasuryEscrowModuleName, fromTreasury)
+		err := k.sendFromModuleToModule(ctx, types.LedgerCategoryEscrow, reference, types.ModuleName, types.TreasuryEscrowModuleName, fromTreasury)
 		if err != nil {
 			return err
 		}
@@ -258,7 +435,7 @@ func (k Keeper) DisburseFundsToEscrow(ctx sdk.Context, reference string, dinAmou
 func (k Keeper) DisburseFundsFromEscrow(ctx sdk.Context, reference string, amount sdk.Int, recipient sdk.AccAddress) error {
 	coins := sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, amount))
 
-	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.TreasuryEscrowModuleName, recipient, coins)
+	err := k.SendFromModuleToAccount(ctx, types.LedgerCategoryEscrow, reference, types.TreasuryEscrowModuleName, recipient, coins)
 	if err != nil {
 		return err
 	}
@@ -294,6 +471,20 @@ func (keeper Keeper) RemoveFromDisbursementQueue(ctx sdk.Context, address sdk.Ac
 	store.Delete(types.DisbursementQueueKey(address, endTime))
 }
 
+func (keeper Keeper) GetDisbursement(ctx sdk.Context, recipient sdk.AccAddress, scheduledFor time.Time) (types.Disbursement, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := store.Get(types.DisbursementQueueKey(recipient, scheduledFor))
+	if bz == nil {
+		return types.Disbursement{}, false
+	}
+
+	var disbursement types.Disbursement
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &disbursement)
+
+	return disbursement, true
+}
+
 func (keeper Keeper) HasDisbursementInQueue(ctx sdk.Context, recipient sdk.AccAddress, scheduledFor time.Time) bool {
 	store := ctx.KVStore(keeper.storeKey)
 	return store.Has(types.DisbursementQueueKey(recipient, scheduledFor))
@@ -334,3 +525,17 @@ func (k Keeper) GetDisbursements(ctx sdk.Context) []types.Disbursement {
 
 	return disbursements
 }
+
+// GetUnapprovedDisbursements returns the scheduled disbursements that are still waiting for approvals. They are dropped
+// by the EndBlocker once their approval deadline passed.
+func (k Keeper) GetUnapprovedDisbursements(ctx sdk.Context) []types.Disbursement {
+	var disbursements []types.Disbursement
+	k.IterateDisbursementQueue(ctx, func(disbursement types.Disbursement) (stop bool) {
+		if ! disbursement.IsApproved() {
+			disbursements = append(disbursements, disbursement)
+		}
+		return false
+	})
+
+	return disbursements
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+)
+
+// setDisbursementThresholds adds two operators next to the manager and the operator of the test input and sets the
+// approval and rejection thresholds.
+func setDisbursementThresholds(input testInput, approvals uint64, rejections uint64) {
+	input.keeper.AddOperator(input.ctx, testAddrs[0])
+	input.keeper.AddOperator(input.ctx, testAddrs[1])
+
+	p := input.keeper.GetParams(input.ctx)
+	p.DisbursementApprovalThreshold = approvals
+	p.DisbursementRejectionThreshold = rejections
+	input.keeper.SetParams(input.ctx, p)
+}
+
+func TestLargeDisbursementCollectsApprovals(t *testing.T) {
+	input := createTestInput(t)
+	recipient := testAddrs[3]
+
+	setDisbursementThresholds(input, 3, 2)
+
+	amount := input.keeper.RiskAssessmentAmount(input.ctx)
+	require.NoError(t, input.keeper.HandleDisburse(input.ctx, operatorAddr, recipient, amount, "large"))
+
+	scheduledFor := input.ctx.BlockTime().Add(input.keeper.RiskAssessmentDuration(input.ctx))
+
+	disbursement, found := input.keeper.GetDisbursement(input.ctx, recipient, scheduledFor)
+	require.True(t, found)
+	require.Equal(t, uint64(3), disbursement.RequiredApprovals)
+	require.False(t, disbursement.IsApproved())
+	require.False(t, disbursement.CountsTowardsLimits())
+
+	// the scheduling operator already counts as the first approval
+	require.Equal(t, types.ErrAlreadyVoted, input.keeper.HandleApproveDisbursement(input.ctx, operatorAddr, recipient, scheduledFor))
+	require.Equal(t, types.ErrNotApprover, input.keeper.HandleApproveDisbursement(input.ctx, recipient, recipient, scheduledFor))
+
+	require.NoError(t, input.keeper.HandleApproveDisbursement(input.ctx, managerAddr, recipient, scheduledFor))
+	require.Equal(t, types.ErrAlreadyVoted, input.keeper.HandleApproveDisbursement(input.ctx, managerAddr, recipient, scheduledFor))
+
+	// a single rejection does not remove it
+	require.NoError(t, input.keeper.HandleRejectDisbursement(input.ctx, testAddrs[1], recipient, scheduledFor))
+	require.Len(t, input.keeper.GetUnapprovedDisbursements(input.ctx), 1)
+
+	require.NoError(t, input.keeper.HandleApproveDisbursement(input.ctx, testAddrs[0], recipient, scheduledFor))
+
+	disbursement, _ = input.keeper.GetDisbursement(input.ctx, recipient, scheduledFor)
+	require.True(t, disbursement.IsApproved())
+	require.Len(t, disbursement.Rejections, 1)
+	require.Empty(t, input.keeper.GetUnapprovedDisbursements(input.ctx))
+
+	input.keeper.AddOperator(input.ctx, testAddrs[2])
+	require.Equal(t, types.ErrDisbursementApproved, input.keeper.HandleApproveDisbursement(input.ctx, testAddrs[2], recipient, scheduledFor))
+}
+
+func TestDisbursementIsRemovedOnceRejected(t *testing.T) {
+	input := createTestInput(t)
+	recipient := testAddrs[3]
+
+	setDisbursementThresholds(input, 3, 2)
+
+	amount := input.keeper.RiskAssessmentAmount(input.ctx)
+	require.NoError(t, input.keeper.HandleDisburse(input.ctx, operatorAddr, recipient, amount, "rejected"))
+
+	scheduledFor := input.ctx.BlockTime().Add(input.keeper.RiskAssessmentDuration(input.ctx))
+
+	require.NoError(t, input.keeper.HandleRejectDisbursement(input.ctx, managerAddr, recipient, scheduledFor))
+	require.True(t, input.keeper.HasDisbursementInQueue(input.ctx, recipient, scheduledFor))
+
+	require.NoError(t, input.keeper.HandleRejectDisbursement(input.ctx, testAddrs[0], recipient, scheduledFor))
+	require.False(t, input.keeper.HasDisbursementInQueue(input.ctx, recipient, scheduledFor))
+
+	require.Equal(t, types.ErrDisbursementNotScheduled, input.keeper.HandleRejectDisbursement(input.ctx, testAddrs[1], recipient, scheduledFor))
+}
+
+func TestLargeDisbursementNeedsReachableThresholds(t *testing.T) {
+	input := createTestInput(t)
+	recipient := testAddrs[3]
+
+	// the manager, the operator and two more operators can't reach five approvals
+	setDisbursementThresholds(input, 5, 1)
+
+	amount := input.keeper.RiskAssessmentAmount(input.ctx)
+	err := input.keeper.HandleDisburse(input.ctx, operatorAddr, recipient, amount, "unreachable")
+	require.True(t, types.ErrDisbursementThresholdUnreachable.Is(err), err)
+
+	require.Empty(t, input.keeper.GetDisbursements(input.ctx))
+	require.False(t, input.keeper.IsDisbursementReferenceSet(input.ctx, "unreachable"))
+}
+
+func TestManagerCancelsApprovedDisbursement(t *testing.T) {
+	input := createTestInput(t)
+	recipient := testAddrs[3]
+
+	amount := input.keeper.RiskAssessmentAmount(input.ctx)
+	require.NoError(t, input.keeper.HandleDisburse(input.ctx, operatorAddr, recipient, amount, "cancelled"))
+
+	scheduledFor := input.ctx.BlockTime().Add(input.keeper.RiskAssessmentDuration(input.ctx))
+
+	require.Equal(t, types.ErrNotManager, input.keeper.HandleCancelDisbursement(input.ctx, operatorAddr, recipient, scheduledFor))
+	require.NoError(t, input.keeper.HandleCancelDisbursement(input.ctx, managerAddr, recipient, scheduledFor))
+	require.Empty(t, input.keeper.GetDisbursements(input.ctx))
+}
+
+func TestUnapprovedDisbursementExpiresAfterDeadline(t *testing.T) {
+	input := createTestInput(t)
+	recipient := testAddrs[3]
+
+	setDisbursementThresholds(input, 3, 2)
+
+	amount := input.keeper.RiskAssessmentAmount(input.ctx)
+	require.NoError(t, input.keeper.HandleDisburse(input.ctx, operatorAddr, recipient, amount, "expired"))
+
+	duration := input.keeper.RiskAssessmentDuration(input.ctx)
+	scheduledFor := input.ctx.BlockTime().Add(duration)
+
+	disbursement, found := input.keeper.GetDisbursement(input.ctx, recipient, scheduledFor)
+	require.True(t, found)
+	require.Equal(t, input.ctx.BlockTime().Add(2 * duration), input.keeper.DisbursementApprovalDeadline(input.ctx, disbursement))
+
+	input.keeper.ExpireDisbursement(input.ctx, disbursement)
+
+	require.False(t, input.keeper.HasDisbursementInQueue(input.ctx, recipient, scheduledFor))
+	require.Empty(t, input.keeper.GetUnapprovedDisbursements(input.ctx))
+	require.False(t, input.keeper.IsDisbursementReferenceSet(input.ctx, "expired"))
+
+	// the released reference can be used again
+	require.NoError(t, input.keeper.HandleDisburse(input.ctx, operatorAddr, recipient, amount, "expired"))
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+	"time"
+)
+
+// FailDisbursement moves a scheduled disbursement that could not be executed to the failed set. Its rate limit usage
+// is released as nothing was paid out. The reference stays reserved until a manager retries or cancels the
+// disbursement, so it can't be paid out a second time under the same reference.
+func (k Keeper) FailDisbursement(ctx sdk.Context, disbursement types.Disbursement, reason error) {
+	k.Logger(ctx).Info(reason.Error())
+
+	k.ReleaseDisbursementUsage(ctx, disbursement)
+
+	k.SetFailedDisbursement(ctx, types.NewFailedDisbursement(disbursement, reason.Error(), ctx.BlockTime()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisbursementFailed,
+			sdk.NewAttribute(types.AttributeKeyScheduledFor, disbursement.ScheduledFor.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, disbursement.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, disbursement.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReference, disbursement.Reference),
+			sdk.NewAttribute(types.AttributeKeyReason, reason.Error()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+}
+
+// HandleRetryDisbursement executes a failed disbursement again. The disbursement stays in the failed set if it fails
+// again. A disbursement that counted towards the rate limits is recorded as usage again once it is paid out, the
+// manager's retry is not held back by the limits.
+func (k Keeper) HandleRetryDisbursement(ctx sdk.Context, manager sdk.AccAddress, recipient sdk.AccAddress, scheduledFor time.Time) error {
+	if ! k.IsManager(ctx, manager) {
+		return types.ErrNotManager
+	}
+
+	failed, found := k.GetFailedDisbursement(ctx, recipient, scheduledFor)
+	if ! found {
+		return types.ErrFailedDisbursementNotFound
+	}
+
+	// the manager takes over from the operator, who might not be an operator anymore
+	err := k.ExecuteDisbursement(ctx, nil, failed.Disbursement)
+	if err != nil {
+		return err
+	}
+
+	if failed.Disbursement.CountsTowardsLimits() {
+		k.RecordDisbursementUsage(ctx, failed.Disbursement.Operator, failed.Disbursement.Amount)
+	}
+
+	k.RemoveFailedDisbursement(ctx, recipient, scheduledFor)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRetryDisbursement,
+			sdk.NewAttribute(types.AttributeKeyScheduledFor, scheduledFor.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, failed.Disbursement.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, manager.String()),
+		),
+	})
+
+	return nil
+}
+
+// HandleCancelFailedDisbursement drops a failed disbursement. Its reference is released so the operator can schedule
+// the payment again, unless the reference also belongs to a vesting disbursement.
+func (k Keeper) HandleCancelFailedDisbursement(ctx sdk.Context, manager sdk.AccAddress, recipient sdk.AccAddress, scheduledFor time.Time) error {
+	if ! k.IsManager(ctx, manager) {
+		return types.ErrNotManager
+	}
+
+	failed, found := k.GetFailedDisbursement(ctx, recipient, scheduledFor)
+	if ! found {
+		return types.ErrFailedDisbursementNotFound
+	}
+
+	k.RemoveFailedDisbursement(ctx, recipient, scheduledFor)
+
+	_, vesting := k.GetVestingDisbursement(ctx, recipient, failed.Disbursement.Reference)
+	if ! vesting {
+		k.RemoveDisbursementReferenceAmount(ctx, failed.Disbursement.Reference)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelFailedDisbursement,
+			sdk.NewAttribute(types.AttributeKeyScheduledFor, scheduledFor.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, manager.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) GetFailedDisbursement(ctx sdk.Context, recipient sdk.AccAddress, scheduledFor time.Time) (types.FailedDisbursement, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.FailedDisbursementKey(recipient, scheduledFor))
+	if bz == nil {
+		return types.FailedDisbursement{}, false
+	}
+
+	var failed types.FailedDisbursement
+	k.cdc.MustUnmarshalBinaryBare(bz, &failed)
+
+	return failed, true
+}
+
+func (k Keeper) SetFailedDisbursement(ctx sdk.Context, failed types.FailedDisbursement) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.FailedDisbursementKey(failed.Disbursement.Recipient, failed.Disbursement.ScheduledFor), k.cdc.MustMarshalBinaryBare(failed))
+}
+
+func (k Keeper) RemoveFailedDisbursement(ctx sdk.Context, recipient sdk.AccAddress, scheduledFor time.Time) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.FailedDisbursementKey(recipient, scheduledFor))
+}
+
+func (k Keeper) HasFailedDisbursement(ctx sdk.Context, recipient sdk.AccAddress, scheduledFor time.Time) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.FailedDisbursementKey(recipient, scheduledFor))
+}
+
+func (k Keeper) IterateFailedDisbursements(ctx sdk.Context, cb func(failed types.FailedDisbursement) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.FailedDisbursementKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var failed types.FailedDisbursement
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &failed)
+
+		if cb(failed) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetFailedDisbursements(ctx sdk.Context) []types.FailedDisbursement {
+	var disbursements []types.FailedDisbursement
+	k.IterateFailedDisbursements(ctx, func(failed types.FailedDisbursement) (stop bool) {
+		disbursements = append(disbursements, failed)
+		return false
+	})
+
+	return disbursements
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+)
+
+// failScheduledDisbursement empties the treasury, executes the disbursement the way the end blocker does and returns
+// the coins that were taken out of the treasury.
+func failScheduledDisbursement(t *testing.T, input testInput, disbursement types.Disbursement) sdk.Coins {
+	treasuryAddress := input.supplyKeeper.GetModuleAddress(types.ModuleName)
+	balance := input.bankKeeper.GetCoins(input.ctx, treasuryAddress)
+
+	_, err := input.bankKeeper.SubtractCoins(input.ctx, treasuryAddress, balance)
+	require.NoError(t, err)
+
+	err = input.keeper.ExecuteDisbursement(input.ctx, disbursement.Operator, disbursement)
+	require.Error(t, err)
+
+	input.keeper.FailDisbursement(input.ctx, disbursement, err)
+	input.keeper.RemoveFromDisbursementQueue(input.ctx, disbursement.Recipient, disbursement.ScheduledFor)
+
+	return balance
+}
+
+func TestRetryFailedDisbursement(t *testing.T) {
+	input := createTestInput(t)
+	recipient := testAddrs[3]
+
+	require.NoError(t, input.keeper.HandleDisburse(input.ctx, operatorAddr, recipient, din(1000000000000), "retried"))
+
+	disbursement, found := input.keeper.GetDisbursement(input.ctx, recipient, input.ctx.BlockTime())
+	require.True(t, found)
+
+	balance := failScheduledDisbursement(t, input, disbursement)
+
+	failed, found := input.keeper.GetFailedDisbursement(input.ctx, recipient, disbursement.ScheduledFor)
+	require.True(t, found)
+	require.NotEmpty(t, failed.Reason)
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, recipient).IsZero())
+
+	// nothing was paid out, so the usage is released but the reference stays reserved
+	operatorUsage, _ := input.keeper.GetDisbursementUsage(input.ctx, operatorAddr)
+	require.True(t, operatorUsage.IsZero())
+	require.Equal(t, types.ErrDuplicateReference, input.keeper.HandleDisburse(input.ctx, operatorAddr, recipient, din(1), "retried"))
+
+	require.Equal(t, types.ErrNotManager, input.keeper.HandleRetryDisbursement(input.ctx, operatorAddr, recipient, disbursement.ScheduledFor))
+
+	// a retry that fails again keeps the disbursement in the failed set
+	require.Error(t, input.keeper.HandleRetryDisbursement(input.ctx, managerAddr, recipient, disbursement.ScheduledFor))
+	require.True(t, input.keeper.HasFailedDisbursement(input.ctx, recipient, disbursement.ScheduledFor))
+
+	_, err := input.bankKeeper.AddCoins(input.ctx, input.supplyKeeper.GetModuleAddress(types.ModuleName), balance)
+	require.NoError(t, err)
+
+	require.NoError(t, input.keeper.HandleRetryDisbursement(input.ctx, managerAddr, recipient, disbursement.ScheduledFor))
+	require.False(t, input.keeper.HasFailedDisbursement(input.ctx, recipient, disbursement.ScheduledFor))
+	require.False(t, input.bankKeeper.GetCoins(input.ctx, recipient).IsZero())
+
+	operatorUsage, _ = input.keeper.GetDisbursementUsage(input.ctx, operatorAddr)
+	require.Equal(t, disbursement.Amount, operatorUsage)
+
+	require.Equal(t, types.ErrFailedDisbursementNotFound, input.keeper.HandleRetryDisbursement(input.ctx, managerAddr, recipient, disbursement.ScheduledFor))
+}
+
+func TestCancelFailedDisbursementReleasesReference(t *testing.T) {
+	input := createTestInput(t)
+	recipient := testAddrs[3]
+
+	require.NoError(t, input.keeper.HandleDisburse(input.ctx, operatorAddr, recipient, din(1000000000000), "cancelled"))
+
+	disbursement, _ := input.keeper.GetDisbursement(input.ctx, recipient, input.ctx.BlockTime())
+	failScheduledDisbursement(t, input, disbursement)
+
+	require.Equal(t, types.ErrNotManager, input.keeper.HandleCancelFailedDisbursement(input.ctx, operatorAddr, recipient, disbursement.ScheduledFor))
+	require.NoError(t, input.keeper.HandleCancelFailedDisbursement(input.ctx, managerAddr, recipient, disbursement.ScheduledFor))
+
+	require.Empty(t, input.keeper.GetFailedDisbursements(input.ctx))
+	require.False(t, input.keeper.IsDisbursementReferenceSet(input.ctx, "cancelled"))
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, recipient).IsZero())
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/anathatech/project-anatha/config"
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+)
+
+// RegisterInvariants registers all treasury invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-references",
+		EscrowReferencesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "distributed-supply",
+		DistributedSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "buyback-denoms",
+		BuyBackDenomsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "disbursement-references",
+		DisbursementReferencesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the treasury module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := EscrowReferencesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = DistributedSupplyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = BuyBackDenomsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return DisbursementReferencesInvariant(k)(ctx)
+	}
+}
+
+// EscrowReferencesInvariant checks that the amounts held in escrow for the disbursement references add up to the
+// balance of the treasury escrow account. References which are not escrowed hold a zero amount.
+func EscrowReferencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.ZeroInt()
+
+		k.IterateDisbursementReferences(ctx, func(reference string, amount sdk.Int) (stop bool) {
+			expected = expected.Add(amount)
+			return false
+		})
+
+		escrow := k.supplyKeeper.GetModuleAccount(ctx, types.TreasuryEscrowModuleName).GetCoins()
+		broken := ! escrow.AmountOf(config.DefaultDenom).Equal(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow references", fmt.Sprintf(
+			"\tsum of escrowed reference amounts: %v\n"+
+				"\ttreasury escrow balance: %v\n",
+			expected, escrow)), broken
+	}
+}
+
+// DistributedSupplyInvariant checks that the treasury has not distributed more than its target supply.
+func DistributedSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		treasury := k.GetTreasury(ctx)
+
+		broken := ! treasury.Distributed.IsAllLTE(treasury.TargetSupply)
+
+		return sdk.FormatInvariant(types.ModuleName, "distributed supply", fmt.Sprintf(
+			"\tdistributed: %v\n"+
+				"\ttarget supply: %v\n",
+			treasury.Distributed, treasury.TargetSupply)), broken
+	}
+}
+
+// BuyBackDenomsInvariant checks that the buyback liquidity fund only holds din and the buyback fund only holds pin.
+func BuyBackDenomsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken bool
+
+		accounts := []struct {
+			name string
+			denom string
+		}{
+			{types.BuyBackLiquidityFundModuleName, config.DefaultStableDenom},
+			{types.BuyBackFundModuleName, config.DefaultDenom},
+		}
+
+		for _, account := range accounts {
+			for _, coin := range k.supplyKeeper.GetModuleAccount(ctx, account.name).GetCoins() {
+				if coin.Denom != account.denom {
+					broken = true
+					msg += fmt.Sprintf("\t%s holds %v, only %s is expected\n", account.name, coin, account.denom)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "buyback denoms", fmt.Sprintf("found unexpected denoms\n%s", msg)), broken
+	}
+}
+
+// DisbursementReferencesInvariant checks that the reference of every scheduled and failed disbursement is set.
+func DisbursementReferencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken bool
+
+		k.IterateDisbursementQueue(ctx, func(disbursement types.Disbursement) (stop bool) {
+			if ! k.IsDisbursementReferenceSet(ctx, disbursement.Reference) {
+				broken = true
+				msg += fmt.Sprintf("\tdisbursement to %s scheduled for %s has no reference %q\n", disbursement.Recipient, disbursement.ScheduledFor, disbursement.Reference)
+			}
+			return false
+		})
+
+		k.IterateFailedDisbursements(ctx, func(failed types.FailedDisbursement) (stop bool) {
+			if ! k.IsDisbursementReferenceSet(ctx, failed.Disbursement.Reference) {
+				broken = true
+				msg += fmt.Sprintf("\tfailed disbursement to %s scheduled for %s has no reference %q\n", failed.Disbursement.Recipient, failed.Disbursement.ScheduledFor, failed.Disbursement.Reference)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "disbursement references", fmt.Sprintf("found disbursements without references\n%s", msg)), broken
+	}
+}
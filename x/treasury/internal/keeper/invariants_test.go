@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+)
+
+func TestInvariantsBreakOnCorruptedStore(t *testing.T) {
+	tests := []struct {
+		name      string
+		invariant func(k Keeper) sdk.Invariant
+		corrupt   func(t *testing.T, input testInput)
+	}{
+		{
+			"escrowed reference without escrow balance",
+			EscrowReferencesInvariant,
+			func(t *testing.T, input testInput) {
+				input.keeper.SetDisbursementReferenceAmount(input.ctx, "escrowed", sdk.NewInt(100))
+			},
+		},
+		{
+			"distributed over the target supply",
+			DistributedSupplyInvariant,
+			func(t *testing.T, input testInput) {
+				treasury := input.keeper.GetTreasury(input.ctx)
+				treasury.Distributed = treasury.TargetSupply.Add(pin(1)...)
+				input.keeper.SetTreasury(input.ctx, treasury)
+			},
+		},
+		{
+			"buyback fund holding din",
+			BuyBackDenomsInvariant,
+			func(t *testing.T, input testInput) {
+				fundModule(t, input, types.BuyBackFundModuleName, din(1))
+			},
+		},
+		{
+			"scheduled disbursement without reference",
+			DisbursementReferencesInvariant,
+			func(t *testing.T, input testInput) {
+				require.NoError(t, input.keeper.HandleDisburse(input.ctx, operatorAddr, testAddrs[3], din(1000), "scheduled"))
+				input.keeper.RemoveDisbursementReferenceAmount(input.ctx, "scheduled")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input := createTestInput(t)
+
+			_, broken := tc.invariant(input.keeper)(input.ctx)
+			require.False(t, broken)
+
+			tc.corrupt(t, input)
+
+			msg, broken := tc.invariant(input.keeper)(input.ctx)
+			require.True(t, broken, msg)
+
+			_, broken = AllInvariants(input.keeper)(input.ctx)
+			require.True(t, broken)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/anathatech/project-anatha/config"
+)
+
+var (
+	managerAddr  = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	operatorAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	testAddrs = []sdk.AccAddress{
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	}
+)
+
+type testInput struct {
+	ctx          sdk.Context
+	keeper       Keeper
+	bankKeeper   bank.Keeper
+	supplyKeeper supply.Keeper
+}
+
+// createTestInput sets up a treasury with the default treasury and params, one manager and one operator. The treasury
+// module account holds the whole target supply.
+func createTestInput(t *testing.T) testInput {
+	input := CreateTestInput(t)
+
+	p := input.Keeper.GetParams(input.Ctx)
+	p.Managers = []sdk.AccAddress{managerAddr}
+	input.Keeper.SetParams(input.Ctx, p)
+
+	input.Keeper.AddOperator(input.Ctx, operatorAddr)
+
+	return testInput{
+		ctx:          input.Ctx,
+		keeper:       input.Keeper,
+		bankKeeper:   input.BankKeeper,
+		supplyKeeper: input.SupplyKeeper,
+	}
+}
+
+func din(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(config.DefaultStableDenom, amount))
+}
+
+func pin(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, amount))
+}
+
+// fundModule adds coins to a module account, creating the account if needed.
+func fundModule(t *testing.T, input testInput, module string, amount sdk.Coins) {
+	input.supplyKeeper.GetModuleAccount(input.ctx, module)
+
+	_, err := input.bankKeeper.AddCoins(input.ctx, input.supplyKeeper.GetModuleAddress(module), amount)
+	require.NoError(t, err)
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+)
+
+// The helpers below wrap the supply keeper so that every movement of treasury funds ends up in the ledger. Nothing is
+// recorded if the transfer fails.
+
+func (k Keeper) sendFromModuleToModule(ctx sdk.Context, category string, reference string, sender string, recipient string, amount sdk.Coins) error {
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, sender, recipient, amount)
+	if err != nil {
+		return err
+	}
+
+	k.RecordLedgerEntry(ctx, category, reference, sender, recipient, amount)
+
+	return nil
+}
+
+func (k Keeper) SendFromModuleToAccount(ctx sdk.Context, category string, reference string, sender string, recipient sdk.AccAddress, amount sdk.Coins) error {
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, sender, recipient, amount)
+	if err != nil {
+		return err
+	}
+
+	k.RecordLedgerEntry(ctx, category, reference, sender, recipient.String(), amount)
+
+	return nil
+}
+
+func (k Keeper) SendFromAccountToModule(ctx sdk.Context, category string, reference string, sender sdk.AccAddress, recipient string, amount sdk.Coins) error {
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, recipient, amount)
+	if err != nil {
+		return err
+	}
+
+	k.RecordLedgerEntry(ctx, category, reference, sender.String(), recipient, amount)
+
+	return nil
+}
+
+func (k Keeper) mintCoins(ctx sdk.Context, reference string, module string, amount sdk.Coins) error {
+	err := k.supplyKeeper.MintCoins(ctx, module, amount)
+	if err != nil {
+		return err
+	}
+
+	k.RecordLedgerEntry(ctx, types.LedgerCategoryMint, reference, "", module, amount)
+
+	return nil
+}
+
+func (k Keeper) burnCoins(ctx sdk.Context, reference string, module string, amount sdk.Coins) error {
+	err := k.supplyKeeper.BurnCoins(ctx, module, amount)
+	if err != nil {
+		return err
+	}
+
+	k.RecordLedgerEntry(ctx, types.LedgerCategoryBurn, reference, module, "", amount)
+
+	return nil
+}
+
+// RecordLedgerEntry appends a movement of funds at the current height to the ledger.
+func (k Keeper) RecordLedgerEntry(ctx sdk.Context, category string, reference string, from string, to string, amount sdk.Coins) {
+	id := k.GetNextLedgerEntryID(ctx)
+
+	k.SetLedgerEntry(ctx, types.NewLedgerEntry(id, ctx.BlockHeight(), ctx.BlockTime(), category, reference, from, to, amount))
+	k.SetNextLedgerEntryID(ctx, id + 1)
+}
+
+func (k Keeper) GetNextLedgerEntryID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NextLedgerEntryIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextLedgerEntryID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.NextLedgerEntryIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) SetLedgerEntry(ctx sdk.Context, entry types.LedgerEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.LedgerKey(entry.Height, entry.ID), k.cdc.MustMarshalBinaryBare(entry))
+}
+
+// IterateLedger iterates over the ledger entries recorded between both heights, inclusive. A zero toHeight iterates
+// up to the latest entry.
+func (k Keeper) IterateLedger(ctx sdk.Context, fromHeight int64, toHeight int64, cb func(entry types.LedgerEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	end := sdk.PrefixEndBytes(types.LedgerKeyPrefix)
+	if toHeight > 0 {
+		end = types.LedgerByHeightKey(toHeight + 1)
+	}
+
+	iterator := store.Iterator(types.LedgerByHeightKey(fromHeight), end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.LedgerEntry
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &entry)
+
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetLedger(ctx sdk.Context, fromHeight int64, toHeight int64) (entries []types.LedgerEntry) {
+	k.IterateLedger(ctx, fromHeight, toHeight, func(entry types.LedgerEntry) (stop bool) {
+		entries = append(entries, entry)
+		return false
+	})
+
+	return entries
+}
+
+// GetLedgerPage returns at most limit ledger entries recorded up to toHeight, inclusive, starting with the entry with
+// the given height and id. The next page starts right after the last entry of a full page, so that only the entries
+// on the page are loaded however deep the export goes.
+func (k Keeper) GetLedgerPage(ctx sdk.Context, fromHeight int64, fromID uint64, toHeight int64, limit int) []types.LedgerEntry {
+	store := ctx.KVStore(k.storeKey)
+
+	end := sdk.PrefixEndBytes(types.LedgerKeyPrefix)
+	if toHeight > 0 {
+		end = types.LedgerByHeightKey(toHeight + 1)
+	}
+
+	iterator := store.Iterator(types.LedgerKey(fromHeight, fromID), end)
+	defer iterator.Close()
+
+	entries := []types.LedgerEntry{}
+
+	for ; iterator.Valid() && len(entries) < limit; iterator.Next() {
+		var entry types.LedgerEntry
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &entry)
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// LedgerRetentionStart returns the first height of the entries kept by the ledger retention.
+func (k Keeper) LedgerRetentionStart(ctx sdk.Context) int64 {
+	retention := k.LedgerRetention(ctx)
+	if uint64(ctx.BlockHeight()) < retention {
+		return 0
+	}
+
+	return ctx.BlockHeight() - int64(retention) + 1
+}
+
+// PruneLedger removes the entries recorded before the ledger retention, at most MaxLedgerEntriesPrunedPerBlock at a
+// time. Lowering the retention through governance prunes the older entries over the following blocks.
+func (k Keeper) PruneLedger(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.LedgerKeyPrefix, types.LedgerByHeightKey(k.LedgerRetentionStart(ctx)))
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < types.MaxLedgerEntriesPrunedPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
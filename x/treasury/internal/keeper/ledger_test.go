@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+)
+
+func TestBuyOrderIsRecordedInTheLedger(t *testing.T) {
+	input := createTestInput(t)
+	buyer := testAddrs[0]
+
+	ctx := input.ctx.WithBlockHeight(10)
+
+	_, err := input.bankKeeper.AddCoins(ctx, buyer, din(1000))
+	require.NoError(t, err)
+	require.NoError(t, input.keeper.HandleCreateBuyOrder(ctx, buyer, din(1000), nil, sdk.Dec{}))
+
+	entries := input.keeper.GetLedger(ctx, 0, 0)
+	require.Len(t, entries, 2)
+
+	require.Equal(t, types.NewLedgerEntry(1, 10, ctx.BlockTime(), types.LedgerCategoryBuyOrder, "", buyer.String(), types.DistributionProfitsModuleName, din(1000)), entries[0])
+	require.Equal(t, types.NewLedgerEntry(2, 10, ctx.BlockTime(), types.LedgerCategoryBuyOrder, "", types.ModuleName, buyer.String(), pin(1000)), entries[1])
+
+	// a transfer that fails is not recorded
+	require.Error(t, input.keeper.SendFromAccountToModule(ctx, types.LedgerCategoryBuyOrder, "", buyer, types.DistributionProfitsModuleName, din(1)))
+	require.Len(t, input.keeper.GetLedger(ctx, 0, 0), 2)
+}
+
+func TestLedgerPagingAndPruning(t *testing.T) {
+	input := createTestInput(t)
+
+	for height := int64(1); height <= 5; height++ {
+		ctx := input.ctx.WithBlockHeight(height)
+		input.keeper.RecordLedgerEntry(ctx, types.LedgerCategoryMint, "", "", types.ModuleName, pin(height))
+		input.keeper.RecordLedgerEntry(ctx, types.LedgerCategoryBurn, "", types.ModuleName, "", pin(height))
+	}
+
+	require.Len(t, input.keeper.GetLedger(input.ctx, 2, 3), 4)
+	require.Len(t, input.keeper.GetLedger(input.ctx, 4, 0), 4)
+
+	// each page starts right after the last entry of the previous one
+	page := input.keeper.GetLedgerPage(input.ctx, 2, 0, 0, 3)
+	require.Len(t, page, 3)
+	require.Equal(t, uint64(3), page[0].ID)
+	require.Equal(t, uint64(5), page[2].ID)
+
+	page = input.keeper.GetLedgerPage(input.ctx, page[2].Height, page[2].ID + 1, 0, 3)
+	require.Len(t, page, 3)
+	require.Equal(t, uint64(6), page[0].ID)
+	require.Equal(t, uint64(8), page[2].ID)
+
+	page = input.keeper.GetLedgerPage(input.ctx, page[2].Height, page[2].ID + 1, 0, 3)
+	require.Len(t, page, 2)
+	require.Empty(t, input.keeper.GetLedgerPage(input.ctx, page[1].Height, page[1].ID + 1, 0, 3))
+
+	require.Len(t, input.keeper.GetLedgerPage(input.ctx, 2, 0, 3, 10), 4)
+	require.Empty(t, input.keeper.GetLedgerPage(input.ctx, 2, 0, 0, 0))
+
+	p := input.keeper.GetParams(input.ctx)
+	p.LedgerRetention = 2
+	input.keeper.SetParams(input.ctx, p)
+
+	// at height 5 only the entries of heights 4 and 5 are kept
+	ctx := input.ctx.WithBlockHeight(5)
+	require.Equal(t, int64(4), input.keeper.LedgerRetentionStart(ctx))
+
+	input.keeper.PruneLedger(ctx)
+
+	entries := input.keeper.GetLedger(ctx, 0, 0)
+	require.Len(t, entries, 4)
+	require.Equal(t, int64(4), entries[0].Height)
+	require.Equal(t, uint64(11), input.keeper.GetNextLedgerEntryID(ctx))
+}
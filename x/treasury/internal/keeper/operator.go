@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/anathatech/project-anatha/x/treasury/internal/types"
 )
 
@@ -44,6 +45,20 @@ func (k Keeper) HandleRemoveOperator(ctx sdk.Context, sender sdk.AccAddress, ope
 		return types.ErrNotManager
 	}
 
+	if k.IsOperator(ctx, operator) && len(k.GetSwapClaims(ctx)) > 0 {
+		// open swap claims must stay attestable by the remaining operators
+		if err := k.CheckSwapAttestationThreshold(ctx, uint64(len(k.GetOperators(ctx))) - 1); err != nil {
+			return err
+		}
+	}
+
+	if k.IsOperator(ctx, operator) && ! k.IsManager(ctx, operator) {
+		// removing the operator must leave enough voters to approve or reject delayed disbursements
+		if err := k.CheckDisbursementThresholds(ctx, k.CountDisbursementVoters(ctx) - 1); err != nil {
+			return err
+		}
+	}
+
 	k.RemoveOperator(ctx, operator)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -61,6 +76,21 @@ func (k Keeper) HandleRemoveOperator(ctx sdk.Context, sender sdk.AccAddress, ope
 	return nil
 }
 
+// CountDisbursementVoters returns the number of distinct managers and operators.
+func (k Keeper) CountDisbursementVoters(ctx sdk.Context) uint64 {
+	return types.CountDisbursementVoters(k.Managers(ctx), k.GetOperators(ctx))
+}
+
+// CheckDisbursementThresholds returns an error if the given number of voters can't reach the disbursement approval or
+// rejection threshold.
+func (k Keeper) CheckDisbursementThresholds(ctx sdk.Context, voters uint64) error {
+	if err := k.GetParams(ctx).ValidateDisbursementThresholds(voters); err != nil {
+		return sdkerrors.Wrap(types.ErrDisbursementThresholdUnreachable, err.Error())
+	}
+
+	return nil
+}
+
 func (k Keeper) AddOperator(ctx sdk.Context, address sdk.AccAddress) {
 	account := k.AccountKeeper.GetAccount(ctx, address)
 	if account == nil {
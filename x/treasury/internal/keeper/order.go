@@ -7,20 +7,13 @@ import (
 	"github.com/anathatech/project-anatha/x/treasury/internal/types"
 )
 
-func (k Keeper) HandleCreateSellOrder(ctx sdk.Context, sender sdk.AccAddress, pinAmount sdk.Coins) error {
-	buyBackPercentage := k.BuyBackPercentage(ctx)
-	stagePrice := k.GetPriceForStage(
-		ctx,
-		k.GetStageFromDistribution(
-			ctx,
-			k.DistributedFromTreasury(ctx),
-		),
-	)
+func (k Keeper) HandleCreateSellOrder(ctx sdk.Context, sender sdk.AccAddress, pinAmount sdk.Coins, minReceived sdk.Coins) error {
+	quote := k.QuoteSellOrder(ctx, pinAmount)
+	dinAmount := quote.Received
 
-	dinAmountInt := pinAmount.AmountOf(config.DefaultDenom).Mul(stagePrice)
-	dinAmountInt = dinAmountInt.ToDec().Mul(buyBackPercentage).TruncateInt()
-
-	dinAmount := sdk.NewCoins(sdk.NewCoin(config.DefaultStableDenom, dinAmountInt))
+	if dinAmount.AmountOf(config.DefaultStableDenom).LT(minReceived.AmountOf(config.DefaultStableDenom)) {
+		return sdkerrors.Wrapf(types.ErrSlippageExceeded, "Sell order would return %s, expected at least %s.", dinAmount, minReceived)
+	}
 
 	if ! dinAmount.IsZero() {
 		err := k.TransferToBuyBackFund(ctx, sender, pinAmount)
@@ -50,19 +43,28 @@ func (k Keeper) HandleCreateSellOrder(ctx sdk.Context, sender sdk.AccAddress, pi
 	return nil
 }
 
-func (k Keeper) HandleCreateBuyOrder(ctx sdk.Context, buyer sdk.AccAddress, dinAmount sdk.Coins) error {
+func (k Keeper) HandleCreateBuyOrder(ctx sdk.Context, buyer sdk.AccAddress, dinAmount sdk.Coins, minReceived sdk.Coins, maxPrice sdk.Dec) error {
 	if ! k.BankKeeper.HasCoins(ctx, buyer, dinAmount) {
 		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "Insufficient funds for ANATHA purchase.")
 	}
 
-	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, buyer, types.DistributionProfitsModuleName, dinAmount)
+	// the quote has to be taken before the payment changes the buyback and treasury balances
+	quote := k.QuoteBuyOrder(ctx, dinAmount)
+
+	if quote.Received.AmountOf(config.DefaultDenom).LT(minReceived.AmountOf(config.DefaultDenom)) {
+		return sdkerrors.Wrapf(types.ErrSlippageExceeded, "Buy order would return %s, expected at least %s.", quote.Received, minReceived)
+	}
+
+	if ! maxPrice.IsNil() && maxPrice.IsPositive() && (quote.Received.IsZero() || quote.AveragePrice.GT(maxPrice)) {
+		return sdkerrors.Wrapf(types.ErrSlippageExceeded, "Buy order would pay %s per PIN, expected at most %s.", quote.AveragePrice, maxPrice)
+	}
+
+	err := k.SendFromAccountToModule(ctx, types.LedgerCategoryBuyOrder, "", buyer, types.DistributionProfitsModuleName, dinAmount)
 	if err != nil {
 		return err
 	}
 
-	_, fromBuyBack, fromTreasury := k.CalculatePinAmountExtended(ctx, dinAmount)
-
-	err = k.DisburseFunds(ctx, nil, buyer, dinAmount, fromBuyBack, fromTreasury)
+	err = k.DisburseFunds(ctx, types.LedgerCategoryBuyOrder, "", nil, buyer, dinAmount, quote.FromBuyBack, quote.FromTreasury)
 	if err != nil {
 		return err
 	}
@@ -70,7 +72,7 @@ func (k Keeper) HandleCreateBuyOrder(ctx sdk.Context, buyer sdk.AccAddress, dinA
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateBuyOrder,
-			sdk.NewAttribute(types.AttributeKeyPinAmount, fromBuyBack.Add(fromTreasury...).String()),
+			sdk.NewAttribute(types.AttributeKeyPinAmount, quote.Received.String()),
 			sdk.NewAttribute(types.AttributeKeyDinAmount, dinAmount.String()),
 		),
 		sdk.NewEvent(
@@ -82,3 +84,49 @@ func (k Keeper) HandleCreateBuyOrder(ctx sdk.Context, buyer sdk.AccAddress, dinA
 
 	return nil
 }
+
+// QuoteBuyOrder returns the PIN a buy order for dinAmount would receive in the current state.
+func (k Keeper) QuoteBuyOrder(ctx sdk.Context, dinAmount sdk.Coins) types.QueryResQuote {
+	total, fromBuyBack, fromTreasury := k.CalculatePinAmountExtended(ctx, dinAmount)
+
+	return types.QueryResQuote{
+		Side: types.QuoteSideBuy,
+		Amount: dinAmount,
+		Received: total,
+		FromBuyBack: fromBuyBack,
+		FromTreasury: fromTreasury,
+		AveragePrice: averagePrice(dinAmount.AmountOf(config.DefaultStableDenom), total.AmountOf(config.DefaultDenom)),
+	}
+}
+
+// QuoteSellOrder returns the DIN a sell order for pinAmount would receive from the buyback fund in the current state.
+// The PIN are priced down the bonding curve from the distributed amount, at the price the treasury sold the last
+// pinAmount PIN for, so that buying and selling back can't return more DIN than was paid.
+func (k Keeper) QuoteSellOrder(ctx sdk.Context, pinAmount sdk.Coins) types.QueryResQuote {
+	dinAmountInt := k.BondingCurve(ctx).DinForPinSold(
+		k.DistributedFromTreasury(ctx),
+		k.CoinsPerStage(ctx),
+		pinAmount.AmountOf(config.DefaultDenom),
+	)
+	dinAmountInt = dinAmountInt.ToDec().Mul(k.BuyBackPercentage(ctx)).TruncateInt()
+
+	dinAmount := sdk.NewCoins(sdk.NewCoin(config.DefaultStableDenom, dinAmountInt))
+
+	return types.QueryResQuote{
+		Side: types.QuoteSideSell,
+		Amount: pinAmount,
+		Received: dinAmount,
+		FromBuyBack: dinAmount,
+		FromTreasury: sdk.NewCoins(),
+		AveragePrice: averagePrice(dinAmountInt, pinAmount.AmountOf(config.DefaultDenom)),
+	}
+}
+
+// averagePrice returns the DIN paid per PIN, or zero if no PIN changes hands.
+func averagePrice(dinAmount sdk.Int, pinAmount sdk.Int) sdk.Dec {
+	if ! pinAmount.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return dinAmount.ToDec().Quo(pinAmount.ToDec())
+}
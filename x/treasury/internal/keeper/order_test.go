@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anathatech/project-anatha/config"
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+)
+
+func testBondingCurves() []types.BondingCurve {
+	return []types.BondingCurve{
+		types.DefaultBondingCurve(),
+		types.NewLinearBondingCurve(sdk.NewInt(3), sdk.NewInt(11)),
+		types.NewExponentialBondingCurve(sdk.NewInt(5), sdk.NewDecWithPrec(105, 2)),
+		types.NewPiecewiseBondingCurve([]types.CurveStep{
+			types.NewCurveStep(sdk.ZeroInt(), sdk.NewInt(2)),
+			types.NewCurveStep(sdk.NewInt(3), sdk.NewInt(9)),
+			types.NewCurveStep(sdk.NewInt(10), sdk.NewInt(40)),
+		}),
+	}
+}
+
+// Buying PIN with a buy order and quoting a sell order for them right afterwards must never return more DIN than
+// was paid, even with the whole sell price going to the seller.
+func TestBondingCurveRoundTripNeverCreatesValue(t *testing.T) {
+	input := createTestInput(t)
+	r := rand.New(rand.NewSource(42))
+
+	p := input.keeper.GetParams(input.ctx)
+	p.BuyBackPercentage = sdk.OneDec()
+	input.keeper.SetParams(input.ctx, p)
+
+	coinsPerStage := input.keeper.CoinsPerStage(input.ctx)
+	buyer := testAddrs[0]
+
+	for _, curve := range testBondingCurves() {
+		input.keeper.SetBondingCurve(input.ctx, curve)
+
+		for i := 0; i < 200; i++ {
+			ctx, _ := input.ctx.CacheContext()
+
+			treasury := input.keeper.GetTreasury(ctx)
+			treasury.Distributed = sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, coinsPerStage.MulRaw(r.Int63n(20)).AddRaw(r.Int63n(coinsPerStage.Int64()))))
+			input.keeper.SetTreasury(ctx, treasury)
+
+			if r.Intn(2) == 0 {
+				input.supplyKeeper.GetModuleAccount(ctx, types.BuyBackFundModuleName)
+				_, err := input.bankKeeper.AddCoins(ctx, input.supplyKeeper.GetModuleAddress(types.BuyBackFundModuleName), sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, coinsPerStage.MulRaw(r.Int63n(3)))))
+				require.NoError(t, err)
+			}
+
+			stagePrice := input.keeper.GetPriceForStage(ctx, input.keeper.GetStageFromDistribution(ctx, input.keeper.DistributedFromTreasury(ctx)))
+			dinAmount := sdk.NewCoins(sdk.NewCoin(config.DefaultStableDenom, coinsPerStage.Mul(stagePrice).MulRaw(r.Int63n(40)).AddRaw(r.Int63n(1000000000))))
+
+			bought, _, _ := input.keeper.CalculatePinAmountExtended(ctx, dinAmount)
+
+			_, err := input.bankKeeper.AddCoins(ctx, buyer, dinAmount)
+			require.NoError(t, err)
+			require.NoError(t, input.keeper.HandleCreateBuyOrder(ctx, buyer, dinAmount, bought, sdk.Dec{}))
+			require.Equal(t, bought, input.bankKeeper.GetCoins(ctx, buyer))
+
+			sold := input.keeper.QuoteSellOrder(ctx, bought).Received
+			require.True(t, sold.AmountOf(config.DefaultStableDenom).LTE(dinAmount.AmountOf(config.DefaultStableDenom)),
+				"%s: paid %s, sold for %s", curve, dinAmount, sold)
+		}
+	}
+}
+
+func TestQuoteSellOrderBelowFirstStage(t *testing.T) {
+	input := createTestInput(t)
+
+	// nothing was distributed yet, so the PIN are priced at the first stage
+	quote := input.keeper.QuoteSellOrder(input.ctx, pin(1000))
+	expected := sdk.NewDec(1000).Mul(input.keeper.BuyBackPercentage(input.ctx)).TruncateInt()
+
+	require.Equal(t, expected, quote.Received.AmountOf(config.DefaultStableDenom))
+}
+
+func TestBuyOrderSlippageLimits(t *testing.T) {
+	input := createTestInput(t)
+	buyer := testAddrs[0]
+
+	// the first stage is priced at 1 DIN per PIN, the buyback fund covers the first 1000 PIN
+	fundModule(t, input, types.BuyBackFundModuleName, pin(1000))
+	_, err := input.bankKeeper.AddCoins(input.ctx, buyer, din(3000))
+	require.NoError(t, err)
+
+	quote := input.keeper.QuoteBuyOrder(input.ctx, din(3000))
+	require.Equal(t, pin(3000), quote.Received)
+	require.Equal(t, pin(1000), quote.FromBuyBack)
+	require.Equal(t, pin(2000), quote.FromTreasury)
+	require.Equal(t, sdk.OneDec(), quote.AveragePrice)
+
+	err = input.keeper.HandleCreateBuyOrder(input.ctx, buyer, din(3000), pin(3001), sdk.Dec{})
+	require.True(t, types.ErrSlippageExceeded.Is(err), err)
+
+	err = input.keeper.HandleCreateBuyOrder(input.ctx, buyer, din(3000), nil, sdk.NewDecWithPrec(99, 2))
+	require.True(t, types.ErrSlippageExceeded.Is(err), err)
+
+	// failed orders leave the balances untouched
+	require.Equal(t, din(3000), input.bankKeeper.GetCoins(input.ctx, buyer))
+
+	require.NoError(t, input.keeper.HandleCreateBuyOrder(input.ctx, buyer, din(3000), pin(3000), sdk.OneDec()))
+	require.Equal(t, pin(3000), input.bankKeeper.GetCoins(input.ctx, buyer))
+	require.True(t, input.supplyKeeper.GetModuleAccount(input.ctx, types.BuyBackFundModuleName).GetCoins().IsZero())
+}
+
+func TestSellOrderSlippageLimit(t *testing.T) {
+	input := createTestInput(t)
+	seller := testAddrs[0]
+
+	fundModule(t, input, types.BuyBackLiquidityFundModuleName, din(1000000))
+	_, err := input.bankKeeper.AddCoins(input.ctx, seller, pin(1000))
+	require.NoError(t, err)
+
+	quote := input.keeper.QuoteSellOrder(input.ctx, pin(1000))
+	require.False(t, quote.Received.IsZero())
+
+	err = input.keeper.HandleCreateSellOrder(input.ctx, seller, pin(1000), quote.Received.Add(din(1)...))
+	require.True(t, types.ErrSlippageExceeded.Is(err), err)
+	require.Equal(t, pin(1000), input.bankKeeper.GetCoins(input.ctx, seller))
+
+	require.NoError(t, input.keeper.HandleCreateSellOrder(input.ctx, seller, pin(1000), quote.Received))
+	require.Equal(t, quote.Received, input.bankKeeper.GetCoins(input.ctx, seller))
+}
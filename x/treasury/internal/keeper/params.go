@@ -26,6 +26,55 @@ func (k Keeper) BuyBackPercentage(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+func (k Keeper) DisbursementApprovalThreshold(ctx sdk.Context) (res uint64) {
+	k.paramspace.Get(ctx, types.KeyDisbursementApprovalThreshold, &res)
+	return
+}
+
+func (k Keeper) DisbursementRejectionThreshold(ctx sdk.Context) (res uint64) {
+	k.paramspace.Get(ctx, types.KeyDisbursementRejectionThreshold, &res)
+	return
+}
+
+func (k Keeper) DisbursementLimitWindow(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDisbursementLimitWindow, &res)
+	return
+}
+
+func (k Keeper) OperatorDisbursementLimit(ctx sdk.Context) (res sdk.Coins) {
+	k.paramspace.Get(ctx, types.KeyOperatorDisbursementLimit, &res)
+	return
+}
+
+func (k Keeper) TreasuryDisbursementLimit(ctx sdk.Context) (res sdk.Coins) {
+	k.paramspace.Get(ctx, types.KeyTreasuryDisbursementLimit, &res)
+	return
+}
+
+func (k Keeper) BondingCurve(ctx sdk.Context) (res types.BondingCurve) {
+	k.paramspace.Get(ctx, types.KeyBondingCurve, &res)
+	return
+}
+
+func (k Keeper) SetBondingCurve(ctx sdk.Context, bondingCurve types.BondingCurve) {
+	k.paramspace.Set(ctx, types.KeyBondingCurve, &bondingCurve)
+}
+
+func (k Keeper) MaxLimitOrderDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyMaxLimitOrderDuration, &res)
+	return
+}
+
+func (k Keeper) SwapAttestationThreshold(ctx sdk.Context) (res uint64) {
+	k.paramspace.Get(ctx, types.KeySwapAttestationThreshold, &res)
+	return
+}
+
+func (k Keeper) LedgerRetention(ctx sdk.Context) (res uint64) {
+	k.paramspace.Get(ctx, types.KeyLedgerRetention, &res)
+	return
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
 	return params
@@ -11,6 +11,12 @@ func (k Keeper) CoinsPerStage(ctx sdk.Context) sdk.Int {
 	return treasury.CoinsPerStage.AmountOf(config.DefaultDenom)
 }
 
+func (k Keeper) SetCoinsPerStage(ctx sdk.Context, coinsPerStage sdk.Coins) {
+	treasury := k.GetTreasury(ctx)
+	treasury.CoinsPerStage = coinsPerStage
+	k.SetTreasury(ctx, treasury)
+}
+
 func (k Keeper) DistributedFromTreasury(ctx sdk.Context) sdk.Int {
 	treasury := k.GetTreasury(ctx)
 	return treasury.Distributed.AmountOf(config.DefaultDenom)
@@ -66,36 +72,11 @@ func (k Keeper) CalculateDinAmountExtended(ctx sdk.Context, pinCoins sdk.Coins)
 }
 
 func (k Keeper) CalculateDinAmount(ctx sdk.Context, pinCoins sdk.Coins) sdk.Coins {
-	pinAmount := pinCoins.AmountOf(config.DefaultDenom)
-	dinAmount := sdk.ZeroInt()
-
-	if pinAmount.LTE(sdk.ZeroInt()) {
-		return sdk.NewCoins(sdk.NewCoin(config.DefaultStableDenom, dinAmount))
-	}
-
-	stage := k.GetStageFromDistribution(ctx, k.DistributedFromTreasury(ctx))
-
-	canonicalStage := stage
-
-	for ! pinAmount.IsZero() {
-
-		remaining := k.RemainingPinInStage(ctx, stage, canonicalStage)
-		stagePrice := k.GetPriceForStage(ctx, stage)
-
-		if remaining.LT(pinAmount) {
-			// we are clearing out a stage and transitioning to the next
-
-			dinAmount = dinAmount.Add(remaining.Mul(stagePrice))
-			pinAmount = pinAmount.Sub(remaining)
-		} else {
-			// we have enough liquidity in the current stage
-
-			dinAmount = dinAmount.Add(pinAmount.Mul(stagePrice))
-			pinAmount = sdk.ZeroInt()
-		}
-
-		stage = stage.Add(sdk.OneInt())
-	}
+	dinAmount := k.BondingCurve(ctx).DinForPin(
+		k.DistributedFromTreasury(ctx),
+		k.CoinsPerStage(ctx),
+		pinCoins.AmountOf(config.DefaultDenom),
+	)
 
 	return sdk.NewCoins(sdk.NewCoin(config.DefaultStableDenom, dinAmount))
 }
@@ -149,67 +130,20 @@ func (k Keeper) CalculatePinAmountExtended(ctx sdk.Context, dinCoins sdk.Coins)
 }
 
 func (k Keeper) CalculatePinAmount(ctx sdk.Context, dinCoins sdk.Coins) sdk.Coins {
-	dinAmount := dinCoins.AmountOf(config.DefaultStableDenom)
-	pinAmount := sdk.ZeroInt()
-
-	if dinAmount.LTE(sdk.ZeroInt()) {
-		return sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, pinAmount))
-	}
-
-	stage := k.GetStageFromDistribution(ctx, k.DistributedFromTreasury(ctx))
-
-	canonicalStage := stage
-
-	for ! dinAmount.IsZero() {
-
-		remainingPin := k.RemainingPinInStage(ctx, stage, canonicalStage)
-		stagePrice := k.GetPriceForStage(ctx, stage)
-
-		remainingDinPrice := remainingPin.Mul(stagePrice)
-
-		if remainingDinPrice.LT(dinAmount) {
-			// we are clearing out a stage and transitioning to the next
-
-			pinAmount = pinAmount.Add(remainingPin)
-			dinAmount = dinAmount.Sub(remainingDinPrice)
-		} else {
-			// we have enough liquidity in the current stage
-
-			pinAmount = pinAmount.Add(dinAmount.Quo(stagePrice))
-			dinAmount = sdk.ZeroInt()
-		}
-
-		stage = stage.Add(sdk.OneInt())
-	}
+	pinAmount := k.BondingCurve(ctx).PinForDin(
+		k.DistributedFromTreasury(ctx),
+		k.CoinsPerStage(ctx),
+		dinCoins.AmountOf(config.DefaultStableDenom),
+	)
 
 	return sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, pinAmount))
 }
 
-// returned amount is $0.0000000001 per PIN
+// returned amount is in units of $0.0000000001 per PIN
 func (k Keeper) GetPriceForStage(ctx sdk.Context, stage sdk.Int) sdk.Int {
-	return stage.Add(sdk.OneInt())
+	return k.BondingCurve(ctx).PriceAt(stage)
 }
 
 func (k Keeper) GetStageFromDistribution(ctx sdk.Context, distribution sdk.Int) sdk.Int {
-	return distribution.Quo(k.CoinsPerStage(ctx))
+	return k.BondingCurve(ctx).Stage(distribution, k.CoinsPerStage(ctx))
 }
-
-func (k Keeper) RemainingPinInStage(ctx sdk.Context, stage sdk.Int, cannonicalStage sdk.Int) sdk.Int {
-	coinsPerStage := k.CoinsPerStage(ctx)
-
-	if stage.LT(cannonicalStage) {
-		// given stage is cleared
-
-		return sdk.ZeroInt()
-	} else if stage.Equal(cannonicalStage) {
-		// we are in the current stage
-
-		return coinsPerStage.Sub(k.DistributedFromTreasury(ctx).Mod(coinsPerStage))
-	} else if stage.GT(cannonicalStage) {
-		// we are in a future stage
-
-		return coinsPerStage
-	} else {
-		panic("This should not happen.")
-	}
-}
\ No newline at end of file
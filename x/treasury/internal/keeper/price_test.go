@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anathatech/project-anatha/config"
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+)
+
+func TestPinAmountFollowsSelectedBondingCurve(t *testing.T) {
+	input := createTestInput(t)
+
+	coinsPerStage := input.keeper.CoinsPerStage(input.ctx)
+	stages := func(prices ...int64) sdk.Coins {
+		dinAmount := sdk.ZeroInt()
+		for _, price := range prices {
+			dinAmount = dinAmount.Add(coinsPerStage.MulRaw(price))
+		}
+
+		return sdk.NewCoins(sdk.NewCoin(config.DefaultStableDenom, dinAmount))
+	}
+
+	for _, tc := range []struct {
+		curve  types.BondingCurve
+		prices []int64
+	}{
+		{types.DefaultBondingCurve(), []int64{1, 2, 3}},
+		{types.NewLinearBondingCurve(sdk.NewInt(3), sdk.NewInt(11)), []int64{3, 14, 25}},
+		{types.NewExponentialBondingCurve(sdk.NewInt(100), sdk.NewDecWithPrec(15, 1)), []int64{100, 150, 225}},
+		{types.NewPiecewiseBondingCurve([]types.CurveStep{
+			types.NewCurveStep(sdk.ZeroInt(), sdk.NewInt(2)),
+			types.NewCurveStep(sdk.NewInt(2), sdk.NewInt(9)),
+		}), []int64{2, 2, 9}},
+	} {
+		input.keeper.SetBondingCurve(input.ctx, tc.curve)
+
+		for stage, price := range tc.prices {
+			require.Equal(t, sdk.NewInt(price), input.keeper.GetPriceForStage(input.ctx, sdk.NewInt(int64(stage))), tc.curve.String())
+		}
+
+		// buying out the first three stages at their prices yields exactly three stages worth of PIN
+		expected := sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, coinsPerStage.MulRaw(3)))
+		require.Equal(t, expected, input.keeper.CalculatePinAmount(input.ctx, stages(tc.prices...)), tc.curve.String())
+
+		// and pricing those PIN costs the DIN that bought them
+		require.Equal(t, stages(tc.prices...), input.keeper.CalculateDinAmount(input.ctx, expected), tc.curve.String())
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"github.com/anathatech/project-anatha/config"
 	"github.com/anathatech/project-anatha/utils"
 	abci "github.com/tendermint/tendermint/abci/types"
-	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,7 +17,15 @@ const (
 	QueryOperators = "operators"
 	QueryDisbursements = "disbursements"
 	QueryPrice = "price"
+	QueryQuote = "quote"
 	QueryDisbursementEscrow = "disbursement-escrow"
+	QueryUnapprovedDisbursements = "unapproved-disbursements"
+	QueryDisbursementUsage = "disbursement-usage"
+	QueryFailedDisbursements = "failed-disbursements"
+	QueryLedger = "ledger"
+	QuerySwapClaims = "swap-claims"
+	QuerySwapClaim = "swap-claim"
+	QueryVestingDisbursements = "vesting-disbursements"
 )
 
 // NewQuerier creates a new querier for treasury clients.
@@ -35,8 +42,24 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryDisbursements(ctx, k)
 		case QueryPrice:
 			return queryPrice(ctx, path[1:], req, k)
+		case QueryQuote:
+			return queryQuote(ctx, path[1:], req, k)
 		case QueryDisbursementEscrow:
 			return queryDisbursementEscrow(ctx, path[1:], req, k)
+		case QueryUnapprovedDisbursements:
+			return queryUnapprovedDisbursements(ctx, k)
+		case QueryDisbursementUsage:
+			return queryDisbursementUsage(ctx, path[1:], req, k)
+		case QueryFailedDisbursements:
+			return queryFailedDisbursements(ctx, k)
+		case QueryLedger:
+			return queryLedger(ctx, req, k)
+		case QuerySwapClaims:
+			return querySwapClaims(ctx, path[1:], req, k)
+		case QuerySwapClaim:
+			return querySwapClaim(ctx, path[1:], req, k)
+		case QueryVestingDisbursements:
+			return queryVestingDisbursements(ctx, path[1:], req, k)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown treasury query endpoint")
 		}
@@ -89,6 +112,63 @@ func queryDisbursements(ctx sdk.Context, k Keeper) ([]byte, error) {
 	return res, nil
 }
 
+func queryUnapprovedDisbursements(ctx sdk.Context, k Keeper) ([]byte, error) {
+	disbursements := k.GetUnapprovedDisbursements(ctx)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, disbursements)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryFailedDisbursements(ctx sdk.Context, k Keeper) ([]byte, error) {
+	disbursements := k.GetFailedDisbursements(ctx)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, disbursements)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryDisbursementUsage returns the treasury usage of the current rate limit window, and the operator usage if an
+// operator is given.
+func queryDisbursementUsage(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var operator sdk.AccAddress
+
+	if len(path) > 0 && path[0] != "" {
+		var err error
+		operator, err = sdk.AccAddressFromBech32(path[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	operatorUsage, treasuryUsage := k.GetDisbursementUsage(ctx, operator)
+
+	usage := types.QueryResDisbursementUsage{
+		Window: k.DisbursementLimitWindow(ctx),
+		TreasuryUsage: treasuryUsage,
+		TreasuryLimit: k.TreasuryDisbursementLimit(ctx),
+		Operator: operator,
+		OperatorUsage: operatorUsage,
+		OperatorLimit: k.OperatorDisbursementLimit(ctx),
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, usage)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryPrice(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	coins, err := utils.ParseAndConvertCoins(path[0])
 	if err != nil {
@@ -118,8 +198,38 @@ func queryPrice(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper)
 	return res, nil
 }
 
+// queryQuote returns the outcome of a buy order for a usd amount or of a sell order for an anatha amount.
+func queryQuote(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	coins, err := utils.ParseAndConvertCoins(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var quote types.QueryResQuote
+
+	if coins.AmountOf(config.DefaultStableDenom).IsPositive() {
+
+		quote = k.QuoteBuyOrder(ctx, coins)
+
+	} else if coins.AmountOf(config.DefaultDenom).IsPositive() {
+
+		quote = k.QuoteSellOrder(ctx, coins)
+
+	} else {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid denomination. Expected usd or anatha.")
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, quote)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryDisbursementEscrow(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
-	reference := strings.ToLower(path[0])
+	reference := path[0]
 
 	found, amount := k.GetDisbursementReferenceAmount(ctx, reference)
 
@@ -136,4 +246,105 @@ func queryDisbursementEscrow(ctx sdk.Context, path []string, req abci.RequestQue
 	}
 
 	return res, nil
-}
\ No newline at end of file
+}
+
+func queryLedger(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryLedgerParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.FromHeight < 0 || params.ToHeight < 0 || (params.ToHeight > 0 && params.ToHeight < params.FromHeight) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid height range %d to %d", params.FromHeight, params.ToHeight)
+	}
+
+	limit := params.Limit
+	if limit <= 0 || limit > types.QueryLedgerMaxLimit {
+		limit = types.QueryLedgerMaxLimit
+	}
+
+	ledger := k.GetLedgerPage(ctx, params.FromHeight, params.FromID, params.ToHeight, limit)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryResLedger(ledger))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// querySwapClaims returns all pending swap claims, or the ones still waiting for the given operator.
+func querySwapClaims(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var claims []types.SwapClaim
+
+	if len(path) > 0 && path[0] != "" {
+		operator, err := sdk.AccAddressFromBech32(path[0])
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+
+		claims = k.GetPendingSwapClaims(ctx, operator)
+	} else {
+		claims = k.GetSwapClaims(ctx)
+	}
+
+	if claims == nil {
+		claims = []types.SwapClaim{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryResSwapClaims(claims))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func querySwapClaim(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	if len(path) == 0 || path[0] == "" {
+		return nil, sdkerrors.Wrap(types.ErrInvalidReference, "reference is required")
+	}
+
+	claim, found := k.GetSwapClaim(ctx, path[0])
+	if ! found {
+		return nil, types.ErrSwapClaimNotFound
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, claim)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryVestingDisbursements returns the vested and unvested amounts of the vesting disbursements of a recipient, or of
+// all recipients if none is given.
+func queryVestingDisbursements(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var disbursements []types.VestingDisbursement
+
+	if len(path) > 0 && path[0] != "" {
+		recipient, err := sdk.AccAddressFromBech32(path[0])
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+
+		disbursements = k.GetVestingDisbursementsByRecipient(ctx, recipient)
+	} else {
+		disbursements = k.GetVestingDisbursements(ctx)
+	}
+
+	result := types.QueryResVestingDisbursements{}
+	for _, disbursement := range disbursements {
+		result = append(result, types.NewQueryResVestingDisbursement(disbursement, ctx.BlockTime()))
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, result)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
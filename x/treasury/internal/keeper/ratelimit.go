@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+	"time"
+)
+
+// GetDisbursementUsage returns the amounts disbursed without a risk assessment delay inside the current rate limit
+// window, by the operator and by the whole treasury.
+func (k Keeper) GetDisbursementUsage(ctx sdk.Context, operator sdk.AccAddress) (operatorUsage sdk.Coins, treasuryUsage sdk.Coins) {
+	operatorUsage = sdk.NewCoins()
+	treasuryUsage = sdk.NewCoins()
+
+	k.IterateDisbursementUsageWindow(ctx, func(usage types.DisbursementUsage) (stop bool) {
+		treasuryUsage = treasuryUsage.Add(usage.Amount...)
+
+		if usage.Operator.Equals(operator) {
+			operatorUsage = operatorUsage.Add(usage.Amount...)
+		}
+
+		return false
+	})
+
+	return operatorUsage, treasuryUsage
+}
+
+// CheckDisbursementLimits returns an error if disbursing the amount would take the operator or the treasury over
+// their rate limit.
+func (k Keeper) CheckDisbursementLimits(ctx sdk.Context, operator sdk.AccAddress, dinAmount sdk.Coins) error {
+	operatorUsage, treasuryUsage := k.GetDisbursementUsage(ctx, operator)
+
+	operatorLimit := k.OperatorDisbursementLimit(ctx)
+	if ! operatorLimit.IsZero() && operatorUsage.Add(dinAmount...).IsAnyGT(operatorLimit) {
+		return sdkerrors.Wrapf(types.ErrDisbursementLimitExceeded, "Operator disbursed %s of %s within %s.", operatorUsage, operatorLimit, k.DisbursementLimitWindow(ctx))
+	}
+
+	treasuryLimit := k.TreasuryDisbursementLimit(ctx)
+	if ! treasuryLimit.IsZero() && treasuryUsage.Add(dinAmount...).IsAnyGT(treasuryLimit) {
+		return sdkerrors.Wrapf(types.ErrDisbursementLimitExceeded, "Treasury disbursed %s of %s within %s.", treasuryUsage, treasuryLimit, k.DisbursementLimitWindow(ctx))
+	}
+
+	return nil
+}
+
+// RecordDisbursementUsage adds the amount to the operator's usage at the current block time.
+func (k Keeper) RecordDisbursementUsage(ctx sdk.Context, operator sdk.AccAddress, dinAmount sdk.Coins) {
+	usage, found := k.GetDisbursementUsageRecord(ctx, operator, ctx.BlockTime())
+	if found {
+		usage.Amount = usage.Amount.Add(dinAmount...)
+	} else {
+		usage = types.NewDisbursementUsage(operator, dinAmount, ctx.BlockTime())
+	}
+
+	k.SetDisbursementUsageRecord(ctx, usage)
+}
+
+// ReleaseDisbursementUsage removes the amount of a disbursement that was not paid out from the usage it was recorded in.
+// Nothing changes if the disbursement does not count towards the limits or its usage left the rate limit window.
+func (k Keeper) ReleaseDisbursementUsage(ctx sdk.Context, disbursement types.Disbursement) {
+	if ! disbursement.CountsTowardsLimits() {
+		return
+	}
+
+	usage, found := k.GetDisbursementUsageRecord(ctx, disbursement.Operator, disbursement.UsageRecordedAt)
+	if ! found {
+		return
+	}
+
+	remaining, negative := usage.Amount.SafeSub(disbursement.Amount)
+	if negative || remaining.IsZero() {
+		k.RemoveDisbursementUsageRecord(ctx, disbursement.Operator, disbursement.UsageRecordedAt)
+		return
+	}
+
+	usage.Amount = remaining
+	k.SetDisbursementUsageRecord(ctx, usage)
+}
+
+func (k Keeper) GetDisbursementUsageRecord(ctx sdk.Context, operator sdk.AccAddress, disbursedAt time.Time) (types.DisbursementUsage, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.DisbursementUsageKey(operator, disbursedAt))
+	if bz == nil {
+		return types.DisbursementUsage{}, false
+	}
+
+	var usage types.DisbursementUsage
+	k.cdc.MustUnmarshalBinaryBare(bz, &usage)
+
+	return usage, true
+}
+
+func (k Keeper) SetDisbursementUsageRecord(ctx sdk.Context, usage types.DisbursementUsage) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.DisbursementUsageKey(usage.Operator, usage.DisbursedAt), k.cdc.MustMarshalBinaryBare(usage))
+}
+
+func (k Keeper) RemoveDisbursementUsageRecord(ctx sdk.Context, operator sdk.AccAddress, disbursedAt time.Time) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.DisbursementUsageKey(operator, disbursedAt))
+}
+
+// PruneDisbursementUsage removes the usage records that fell out of the rate limit window.
+func (k Keeper) PruneDisbursementUsage(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.DisbursementUsageKeyPrefix, types.DisbursementUsageByTimeKey(k.disbursementWindowStart(ctx)))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) IterateDisbursementUsageWindow(ctx sdk.Context, cb func(usage types.DisbursementUsage) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.DisbursementUsageByTimeKey(k.disbursementWindowStart(ctx)), sdk.PrefixEndBytes(types.DisbursementUsageByTimeKey(ctx.BlockTime())))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.DisbursementUsage
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &usage)
+
+		if cb(usage) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateDisbursementUsage(ctx sdk.Context, cb func(usage types.DisbursementUsage) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DisbursementUsageKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.DisbursementUsage
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &usage)
+
+		if cb(usage) {
+			break
+		}
+	}
+}
+
+func (k Keeper) disbursementWindowStart(ctx sdk.Context) time.Time {
+	return ctx.BlockTime().Add(-k.DisbursementLimitWindow(ctx))
+}
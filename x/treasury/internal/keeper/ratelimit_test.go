@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+)
+
+func setDisbursementLimits(input testInput, operatorLimit int64, treasuryLimit int64) {
+	p := input.keeper.GetParams(input.ctx)
+	p.DisbursementLimitWindow = time.Hour
+	p.OperatorDisbursementLimit = din(operatorLimit)
+	p.TreasuryDisbursementLimit = din(treasuryLimit)
+	input.keeper.SetParams(input.ctx, p)
+}
+
+func TestDisbursementsOverTheLimitAreDelayed(t *testing.T) {
+	input := createTestInput(t)
+	recipient := testAddrs[3]
+
+	setDisbursementLimits(input, 300, 500)
+
+	require.NoError(t, input.keeper.HandleDisburse(input.ctx, operatorAddr, recipient, din(200), "first"))
+
+	disbursement, found := input.keeper.GetDisbursement(input.ctx, recipient, input.ctx.BlockTime())
+	require.True(t, found)
+	require.True(t, disbursement.IsApproved())
+	require.True(t, disbursement.CountsTowardsLimits())
+
+	operatorUsage, treasuryUsage := input.keeper.GetDisbursementUsage(input.ctx, operatorAddr)
+	require.Equal(t, din(200), operatorUsage)
+	require.Equal(t, din(200), treasuryUsage)
+
+	// the second disbursement would take the operator over its limit and needs a risk assessment instead
+	require.NoError(t, input.keeper.HandleDisburse(input.ctx, operatorAddr, recipient, din(200), "second"))
+
+	scheduledFor := input.ctx.BlockTime().Add(input.keeper.RiskAssessmentDuration(input.ctx))
+	disbursement, found = input.keeper.GetDisbursement(input.ctx, recipient, scheduledFor)
+	require.True(t, found)
+	require.False(t, disbursement.CountsTowardsLimits())
+
+	operatorUsage, _ = input.keeper.GetDisbursementUsage(input.ctx, operatorAddr)
+	require.Equal(t, din(200), operatorUsage)
+
+	// escrow disbursements can't be delayed and are refused
+	err := input.keeper.HandleDisburseToEscrow(input.ctx, operatorAddr, din(200), "escrow")
+	require.True(t, types.ErrDisbursementLimitExceeded.Is(err), err)
+}
+
+func TestTreasuryLimitSpansOperators(t *testing.T) {
+	input := createTestInput(t)
+	other := testAddrs[0]
+
+	input.keeper.AddOperator(input.ctx, other)
+	setDisbursementLimits(input, 300, 500)
+
+	input.keeper.RecordDisbursementUsage(input.ctx, operatorAddr, din(300))
+	require.NoError(t, input.keeper.CheckDisbursementLimits(input.ctx, other, din(200)))
+
+	input.keeper.RecordDisbursementUsage(input.ctx, other, din(200))
+	err := input.keeper.CheckDisbursementLimits(input.ctx, other, din(1))
+	require.True(t, types.ErrDisbursementLimitExceeded.Is(err), err)
+}
+
+func TestDisbursementUsageLeavesTheWindow(t *testing.T) {
+	input := createTestInput(t)
+
+	setDisbursementLimits(input, 300, 500)
+
+	input.keeper.RecordDisbursementUsage(input.ctx, operatorAddr, din(300))
+	require.Error(t, input.keeper.CheckDisbursementLimits(input.ctx, operatorAddr, din(1)))
+
+	later := input.ctx.WithBlockTime(input.ctx.BlockTime().Add(30 * time.Minute))
+	input.keeper.RecordDisbursementUsage(later, operatorAddr, din(100))
+
+	// only the later usage is still inside the window
+	ctx := input.ctx.WithBlockTime(input.ctx.BlockTime().Add(time.Hour + time.Second))
+	operatorUsage, _ := input.keeper.GetDisbursementUsage(ctx, operatorAddr)
+	require.Equal(t, din(100), operatorUsage)
+	require.NoError(t, input.keeper.CheckDisbursementLimits(ctx, operatorAddr, din(200)))
+
+	input.keeper.PruneDisbursementUsage(ctx)
+
+	var records []types.DisbursementUsage
+	input.keeper.IterateDisbursementUsage(ctx, func(usage types.DisbursementUsage) (stop bool) {
+		records = append(records, usage)
+		return false
+	})
+	require.Len(t, records, 1)
+	require.Equal(t, later.BlockTime(), records[0].DisbursedAt)
+}
+
+func TestCancelledDisbursementReleasesUsage(t *testing.T) {
+	input := createTestInput(t)
+	recipient := testAddrs[3]
+
+	setDisbursementLimits(input, 300, 500)
+
+	require.NoError(t, input.keeper.HandleDisburse(input.ctx, operatorAddr, recipient, din(300), "released"))
+	require.NoError(t, input.keeper.HandleCancelDisbursement(input.ctx, managerAddr, recipient, input.ctx.BlockTime()))
+
+	operatorUsage, _ := input.keeper.GetDisbursementUsage(input.ctx, operatorAddr)
+	require.True(t, operatorUsage.IsZero())
+	require.NoError(t, input.keeper.CheckDisbursementLimits(input.ctx, operatorAddr, din(300)))
+}
@@ -3,14 +3,11 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/anathatech/project-anatha/x/treasury/internal/types"
-	"strings"
 )
 
 func (k Keeper) SetDisbursementReferenceAmount(ctx sdk.Context, reference string, amount sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
 
-	reference = strings.ToLower(reference)
-
 	store.Set(types.GetDisbursementReferenceKey(reference), k.cdc.MustMarshalBinaryBare(amount))
 }
 
@@ -10,23 +10,23 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 		return nil
 	}
 
-	return k.supplyKeeper.MintCoins(ctx, types.ModuleName, newCoins)
+	return k.mintCoins(ctx, "", types.ModuleName, newCoins)
 }
 
-func (k Keeper) MintCoinsToBuyBackLiquidityFund(ctx sdk.Context, newCoins sdk.Coins) error {
+func (k Keeper) MintCoinsToBuyBackLiquidityFund(ctx sdk.Context, reference string, newCoins sdk.Coins) error {
 	if newCoins.Empty() {
 		return nil
 	}
 
-	return k.supplyKeeper.MintCoins(ctx, types.BuyBackLiquidityFundModuleName, newCoins)
+	return k.mintCoins(ctx, reference, types.BuyBackLiquidityFundModuleName, newCoins)
 }
 
-func (k Keeper) BurnCoinsFromBuyBackLiquidityFund(ctx sdk.Context, amount sdk.Coins) error {
+func (k Keeper) BurnCoinsFromBuyBackLiquidityFund(ctx sdk.Context, reference string, amount sdk.Coins) error {
 	if amount.Empty() {
 		return nil
 	}
 
-	return k.supplyKeeper.BurnCoins(ctx, types.BuyBackLiquidityFundModuleName, amount)
+	return k.burnCoins(ctx, reference, types.BuyBackLiquidityFundModuleName, amount)
 }
 
 
@@ -35,7 +35,7 @@ func (k Keeper) TotalSupply(ctx sdk.Context) sdk.Coins {
 }
 
 func (k Keeper) TransferFromBuyBackFund(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) error {
-	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.BuyBackLiquidityFundModuleName, recipient , amount)
+	err := k.SendFromModuleToAccount(ctx, types.LedgerCategorySellOrder, "", types.BuyBackLiquidityFundModuleName, recipient, amount)
 	if err != nil {
 		return err
 	}
@@ -44,7 +44,7 @@ func (k Keeper) TransferFromBuyBackFund(ctx sdk.Context, recipient sdk.AccAddres
 }
 
 func (k Keeper) TransferToBuyBackFund(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins) error {
-	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.BuyBackFundModuleName, amount)
+	err := k.SendFromAccountToModule(ctx, types.LedgerCategorySellOrder, "", sender, types.BuyBackFundModuleName, amount)
 	if err != nil {
 		return err
 	}
@@ -52,8 +52,8 @@ func (k Keeper) TransferToBuyBackFund(ctx sdk.Context, sender sdk.AccAddress, am
 	return nil
 }
 
-func (k Keeper) BurnFromDistributionProfits(ctx sdk.Context, amount sdk.Coins) error {
-	err := k.supplyKeeper.BurnCoins(ctx, types.DistributionProfitsModuleName, amount)
+func (k Keeper) BurnFromDistributionProfits(ctx sdk.Context, reference string, amount sdk.Coins) error {
+	err := k.burnCoins(ctx, reference, types.DistributionProfitsModuleName, amount)
 	if err != nil {
 		return err
 	}
@@ -61,8 +61,8 @@ func (k Keeper) BurnFromDistributionProfits(ctx sdk.Context, amount sdk.Coins) e
 	return nil
 }
 
-func (k Keeper) TransferFromDistributionProfitsToBuyBackLiquidity(ctx sdk.Context, amount sdk.Coins) error {
-	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.DistributionProfitsModuleName, types.BuyBackLiquidityFundModuleName, amount)
+func (k Keeper) TransferFromDistributionProfitsToBuyBackLiquidity(ctx sdk.Context, reference string, amount sdk.Coins) error {
+	err := k.sendFromModuleToModule(ctx, types.LedgerCategoryProposal, reference, types.DistributionProfitsModuleName, types.BuyBackLiquidityFundModuleName, amount)
 	if err != nil {
 		return err
 	}
@@ -70,8 +70,8 @@ func (k Keeper) TransferFromDistributionProfitsToBuyBackLiquidity(ctx sdk.Contex
 	return nil
 }
 
-func (k Keeper) TransferFromTreasuryToSwapEscrow(ctx sdk.Context, amount sdk.Coins) error {
-	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.SwapEscrowModuleName, amount)
+func (k Keeper) TransferFromTreasuryToSwapEscrow(ctx sdk.Context, reference string, amount sdk.Coins) error {
+	err := k.sendFromModuleToModule(ctx, types.LedgerCategoryProposal, reference, types.ModuleName, types.SwapEscrowModuleName, amount)
 	if err != nil {
 		return err
 	}
@@ -83,8 +83,8 @@ func (k Keeper) TransferFromTreasuryToSwapEscrow(ctx sdk.Context, amount sdk.Coi
 	return nil
 }
 
-func (k Keeper) TransferFromSwapEscrowToBuyBack(ctx sdk.Context, amount sdk.Coins) error {
-	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.SwapEscrowModuleName, types.BuyBackFundModuleName, amount)
+func (k Keeper) TransferFromSwapEscrowToBuyBack(ctx sdk.Context, reference string, amount sdk.Coins) error {
+	err := k.sendFromModuleToModule(ctx, types.LedgerCategoryProposal, reference, types.SwapEscrowModuleName, types.BuyBackFundModuleName, amount)
 	if err != nil {
 		return err
 	}
@@ -92,8 +92,8 @@ func (k Keeper) TransferFromSwapEscrowToBuyBack(ctx sdk.Context, amount sdk.Coin
 	return nil
 }
 
-func (k Keeper) TransferFromSwapEscrow(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) error {
-	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.SwapEscrowModuleName, recipient, amount)
+func (k Keeper) TransferFromSwapEscrow(ctx sdk.Context, reference string, recipient sdk.AccAddress, amount sdk.Coins) error {
+	err := k.SendFromModuleToAccount(ctx, types.LedgerCategorySwap, reference, types.SwapEscrowModuleName, recipient, amount)
 	if err != nil {
 		return err
 	}
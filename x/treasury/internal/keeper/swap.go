@@ -1,33 +1,116 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/anathatech/project-anatha/x/treasury/internal/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-func (k Keeper) HandleSwap(ctx sdk.Context, operator sdk.AccAddress, recipient sdk.AccAddress, amount sdk.Coins, reference string) error {
-	if ! k.IsOperator(ctx, operator) {
-		return types.ErrNotOperator
+// HandleClaimSwap opens a claim for swapped legacy chain tokens. The claim is signed by its recipient and counts no
+// attestations yet, it is paid out of the swap escrow once enough operators attested it.
+func (k Keeper) HandleClaimSwap(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, reference string) error {
+	if err := k.CheckSwapAttestationThreshold(ctx, uint64(len(k.GetOperators(ctx)))); err != nil {
+		return err
 	}
 
 	if k.IsDisbursementReferenceSet(ctx, reference) {
 		return types.ErrDuplicateReference
 	}
 
-	err := k.TransferFromSwapEscrow(ctx, recipient, amount)
+	if k.HasSwapClaim(ctx, reference) {
+		return types.ErrSwapClaimExists
+	}
+
+	claim := types.NewSwapClaim(reference, recipient, amount, ctx.BlockTime(), k.SwapAttestationThreshold(ctx))
+
+	k.SetSwapClaim(ctx, claim)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimSwap,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReference, reference),
+			sdk.NewAttribute(types.AttributeKeyRequiredAttestations, fmt.Sprintf("%d", claim.RequiredAttestations)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, recipient.String()),
+		),
+	})
+
+	return nil
+}
+
+// HandleAttestSwap records the attestation of an operator. The recipient and amount have to match the claim, the
+// claim is paid out with the attestation that reaches the threshold.
+func (k Keeper) HandleAttestSwap(ctx sdk.Context, operator sdk.AccAddress, recipient sdk.AccAddress, amount sdk.Coins, reference string) error {
+	claim, err := k.getSwapClaimForVote(ctx, operator, reference)
+	if err != nil {
+		return err
+	}
+
+	reference = claim.Reference
 
+	if ! claim.Matches(recipient, amount) {
+		return types.ErrSwapClaimMismatch
+	}
+
+	claim.Attestations = append(claim.Attestations, operator)
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAttestSwap,
+			sdk.NewAttribute(types.AttributeKeyReference, reference),
+			sdk.NewAttribute(types.AttributeKeyAttestations, fmt.Sprintf("%d", len(claim.Attestations))),
+			sdk.NewAttribute(types.AttributeKeyRequiredAttestations, fmt.Sprintf("%d", claim.RequiredAttestations)),
+		),
+	}
+
+	swapEvents, err := k.settleSwapClaim(ctx, claim)
+	if err != nil {
+		return err
+	}
+
+	events = append(events, swapEvents...)
+	events = append(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		sdk.NewAttribute(types.AttributeKeySender, operator.String()),
+	))
+
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
+
+// HandleRejectSwap records the rejection of an operator. The claim is removed once it collected as many rejections as
+// it needs attestations, which frees the reference for a new claim.
+func (k Keeper) HandleRejectSwap(ctx sdk.Context, operator sdk.AccAddress, reference string) error {
+	claim, err := k.getSwapClaimForVote(ctx, operator, reference)
 	if err != nil {
 		return err
 	}
 
-	k.SetDisbursementReferenceAmount(ctx, reference, sdk.ZeroInt())
+	reference = claim.Reference
+
+	claim.Rejections = append(claim.Rejections, operator)
+
+	if claim.IsRejected() {
+		k.RemoveSwapClaim(ctx, reference)
+	} else {
+		k.SetSwapClaim(ctx, claim)
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeSwap,
-			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
-			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			types.EventTypeRejectSwap,
 			sdk.NewAttribute(types.AttributeKeyReference, reference),
+			sdk.NewAttribute(types.AttributeKeyRejections, fmt.Sprintf("%d", len(claim.Rejections))),
+			sdk.NewAttribute(types.AttributeKeyRequiredAttestations, fmt.Sprintf("%d", claim.RequiredAttestations)),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -38,3 +121,127 @@ func (k Keeper) HandleSwap(ctx sdk.Context, operator sdk.AccAddress, recipient s
 
 	return nil
 }
+
+// settleSwapClaim pays out the claim once it collected enough attestations and stores it otherwise.
+func (k Keeper) settleSwapClaim(ctx sdk.Context, claim types.SwapClaim) (sdk.Events, error) {
+	if ! claim.IsAttested() {
+		k.SetSwapClaim(ctx, claim)
+
+		return sdk.Events{}, nil
+	}
+
+	err := k.TransferFromSwapEscrow(ctx, claim.Reference, claim.Recipient, claim.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetDisbursementReferenceAmount(ctx, claim.Reference, sdk.ZeroInt())
+	k.RemoveSwapClaim(ctx, claim.Reference)
+
+	return sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSwap,
+			sdk.NewAttribute(types.AttributeKeyRecipient, claim.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, claim.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReference, claim.Reference),
+		),
+	}, nil
+}
+
+// CheckSwapAttestationThreshold returns an error if the given number of operators can't reach the swap attestation
+// threshold.
+func (k Keeper) CheckSwapAttestationThreshold(ctx sdk.Context, operators uint64) error {
+	if threshold := k.SwapAttestationThreshold(ctx); threshold > operators {
+		return sdkerrors.Wrapf(types.ErrSwapThresholdUnreachable, "swap attestation threshold %d is higher than the %d operators", threshold, operators)
+	}
+
+	return nil
+}
+
+func (k Keeper) getSwapClaimForVote(ctx sdk.Context, operator sdk.AccAddress, reference string) (types.SwapClaim, error) {
+	if ! k.IsOperator(ctx, operator) {
+		return types.SwapClaim{}, types.ErrNotOperator
+	}
+
+	claim, found := k.GetSwapClaim(ctx, reference)
+	if ! found {
+		return types.SwapClaim{}, types.ErrSwapClaimNotFound
+	}
+
+	if claim.HasVoted(operator) {
+		return types.SwapClaim{}, types.ErrAlreadyVoted
+	}
+
+	return claim, nil
+}
+
+func (k Keeper) GetSwapClaim(ctx sdk.Context, reference string) (types.SwapClaim, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetSwapClaimKey(reference))
+	if bz == nil {
+		return types.SwapClaim{}, false
+	}
+
+	var claim types.SwapClaim
+	k.cdc.MustUnmarshalBinaryBare(bz, &claim)
+
+	return claim, true
+}
+
+func (k Keeper) SetSwapClaim(ctx sdk.Context, claim types.SwapClaim) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetSwapClaimKey(claim.Reference), k.cdc.MustMarshalBinaryBare(claim))
+}
+
+func (k Keeper) RemoveSwapClaim(ctx sdk.Context, reference string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetSwapClaimKey(reference))
+}
+
+func (k Keeper) HasSwapClaim(ctx sdk.Context, reference string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetSwapClaimKey(reference))
+}
+
+func (k Keeper) IterateSwapClaims(ctx sdk.Context, cb func(claim types.SwapClaim) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SwapClaimKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.SwapClaim
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &claim)
+
+		if cb(claim) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetSwapClaims(ctx sdk.Context) []types.SwapClaim {
+	var claims []types.SwapClaim
+	k.IterateSwapClaims(ctx, func(claim types.SwapClaim) (stop bool) {
+		claims = append(claims, claim)
+		return false
+	})
+
+	return claims
+}
+
+// GetPendingSwapClaims returns the claims the operator has not attested or rejected yet.
+func (k Keeper) GetPendingSwapClaims(ctx sdk.Context, operator sdk.AccAddress) []types.SwapClaim {
+	var claims []types.SwapClaim
+	k.IterateSwapClaims(ctx, func(claim types.SwapClaim) (stop bool) {
+		if ! claim.HasVoted(operator) {
+			claims = append(claims, claim)
+		}
+		return false
+	})
+
+	return claims
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+)
+
+func TestSwapClaimIsPaidOnceAttested(t *testing.T) {
+	input := createTestInput(t)
+	second, third, recipient := testAddrs[0], testAddrs[1], testAddrs[3]
+
+	fundModule(t, input, types.SwapEscrowModuleName, pin(1000))
+
+	// the default threshold needs a second operator
+	err := input.keeper.HandleClaimSwap(input.ctx, recipient, pin(600), "swap-1")
+	require.True(t, types.ErrSwapThresholdUnreachable.Is(err), err)
+
+	input.keeper.AddOperator(input.ctx, second)
+	input.keeper.AddOperator(input.ctx, third)
+
+	require.NoError(t, input.keeper.HandleClaimSwap(input.ctx, recipient, pin(600), "Swap-1"))
+	require.Equal(t, types.ErrSwapClaimExists, input.keeper.HandleClaimSwap(input.ctx, second, pin(600), "swap-1"))
+
+	// the recipient opening the claim doesn't count as an attestation
+	claim, found := input.keeper.GetSwapClaim(input.ctx, "swap-1")
+	require.True(t, found)
+	require.Empty(t, claim.Attestations)
+	require.Len(t, input.keeper.GetPendingSwapClaims(input.ctx, operatorAddr), 1)
+
+	require.Equal(t, types.ErrNotOperator, input.keeper.HandleAttestSwap(input.ctx, recipient, recipient, pin(600), "swap-1"))
+	require.Equal(t, types.ErrSwapClaimMismatch, input.keeper.HandleAttestSwap(input.ctx, second, recipient, pin(700), "swap-1"))
+	require.Equal(t, types.ErrSwapClaimMismatch, input.keeper.HandleAttestSwap(input.ctx, second, third, pin(600), "swap-1"))
+
+	require.NoError(t, input.keeper.HandleAttestSwap(input.ctx, operatorAddr, recipient, pin(600), "swap-1"))
+	require.Equal(t, types.ErrAlreadyVoted, input.keeper.HandleAttestSwap(input.ctx, operatorAddr, recipient, pin(600), "swap-1"))
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, recipient).IsZero())
+	require.Empty(t, input.keeper.GetPendingSwapClaims(input.ctx, operatorAddr))
+
+	require.NoError(t, input.keeper.HandleAttestSwap(input.ctx, second, recipient, pin(600), "swap-1"))
+
+	require.Equal(t, pin(600), input.bankKeeper.GetCoins(input.ctx, recipient))
+	require.Equal(t, pin(400), input.supplyKeeper.GetModuleAccount(input.ctx, types.SwapEscrowModuleName).GetCoins())
+	require.False(t, input.keeper.HasSwapClaim(input.ctx, "swap-1"))
+
+	// the reference can't be paid twice, whatever its case
+	require.Equal(t, types.ErrDuplicateReference, input.keeper.HandleClaimSwap(input.ctx, recipient, pin(600), "SWAP-1"))
+	require.Equal(t, types.ErrSwapClaimNotFound, input.keeper.HandleAttestSwap(input.ctx, third, recipient, pin(600), "swap-1"))
+}
+
+func TestRejectedSwapClaimFreesTheReference(t *testing.T) {
+	input := createTestInput(t)
+	second, squatter, recipient := testAddrs[0], testAddrs[1], testAddrs[3]
+
+	fundModule(t, input, types.SwapEscrowModuleName, pin(1000))
+	input.keeper.AddOperator(input.ctx, second)
+
+	require.NoError(t, input.keeper.HandleClaimSwap(input.ctx, squatter, pin(600), "rejected"))
+
+	// the claim is removed once it collected as many rejections as it needs attestations
+	require.NoError(t, input.keeper.HandleRejectSwap(input.ctx, operatorAddr, "rejected"))
+	require.Equal(t, types.ErrAlreadyVoted, input.keeper.HandleRejectSwap(input.ctx, operatorAddr, "rejected"))
+	require.True(t, input.keeper.HasSwapClaim(input.ctx, "rejected"))
+	require.NoError(t, input.keeper.HandleRejectSwap(input.ctx, second, "rejected"))
+	require.False(t, input.keeper.HasSwapClaim(input.ctx, "rejected"))
+
+	require.NoError(t, input.keeper.HandleClaimSwap(input.ctx, recipient, pin(500), "rejected"))
+	require.NoError(t, input.keeper.HandleAttestSwap(input.ctx, operatorAddr, recipient, pin(500), "rejected"))
+	require.NoError(t, input.keeper.HandleAttestSwap(input.ctx, second, recipient, pin(500), "rejected"))
+	require.Equal(t, pin(500), input.bankKeeper.GetCoins(input.ctx, recipient))
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, squatter).IsZero())
+}
+
+func TestOperatorsNeededForOpenSwapClaimsCantBeRemoved(t *testing.T) {
+	input := createTestInput(t)
+	second, third, recipient := testAddrs[0], testAddrs[1], testAddrs[3]
+
+	fundModule(t, input, types.SwapEscrowModuleName, pin(1000))
+	input.keeper.AddOperator(input.ctx, second)
+
+	require.NoError(t, input.keeper.HandleClaimSwap(input.ctx, recipient, pin(600), "open"))
+
+	err := input.keeper.HandleRemoveOperator(input.ctx, managerAddr, second)
+	require.True(t, types.ErrSwapThresholdUnreachable.Is(err), err)
+
+	input.keeper.AddOperator(input.ctx, third)
+	require.NoError(t, input.keeper.HandleRemoveOperator(input.ctx, managerAddr, second))
+	require.False(t, input.keeper.IsOperator(input.ctx, second))
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+	orderbook "github.com/anathatech/project-anatha/x/treasury/orderbook/types"
+)
+
+// TestInput holds a treasury keeper on top of in memory stores, shared by the tests of the treasury and the order book.
+type TestInput struct {
+	Ctx          sdk.Context
+	Cdc          *codec.Codec
+	StoreKey     sdk.StoreKey
+	Keeper       Keeper
+	BankKeeper   bank.Keeper
+	SupplyKeeper supply.Keeper
+}
+
+func MakeTestCodec() *codec.Codec {
+	cdc := codec.New()
+
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	return cdc
+}
+
+// CreateTestInput sets up a treasury with the default treasury and params. The treasury module account holds the whole
+// target supply.
+func CreateTestInput(t *testing.T) TestInput {
+	// the denoms registered by the app, the default treasury and params are converted from anatha and usd
+	_ = sdk.RegisterDenom("anatha", sdk.OneDec())
+	_ = sdk.RegisterDenom("pin", sdk.NewDecWithPrec(1, 8))
+	_ = sdk.RegisterDenom("usd", sdk.OneDec())
+	_ = sdk.RegisterDenom("din", sdk.NewDecWithPrec(1, 10))
+
+	keyTreasury := sdk.NewKVStoreKey(types.StoreKey)
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+
+	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := MakeTestCodec()
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "treasury-test", Time: time.Unix(1600000000, 0).UTC()}, false, log.NewNopLogger())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), map[string]bool{})
+
+	maccPerms := map[string][]string{
+		types.ModuleName:                     {supply.Minter},
+		types.BuyBackLiquidityFundModuleName: {supply.Minter, supply.Burner},
+		types.BuyBackFundModuleName:          nil,
+		types.DistributionProfitsModuleName:  {supply.Burner},
+		types.TreasuryEscrowModuleName:       nil,
+		types.SwapEscrowModuleName:           nil,
+		orderbook.EscrowModuleName:           nil,
+	}
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+
+	keeper := NewKeeper(cdc, keyTreasury, pk.Subspace(types.DefaultParamspace), supplyKeeper, accountKeeper, bankKeeper)
+
+	treasury := types.DefaultInitialTreasury()
+	keeper.SetTreasury(ctx, treasury)
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	require.NoError(t, supplyKeeper.MintCoins(ctx, types.ModuleName, treasury.TargetSupply))
+
+	return TestInput{
+		Ctx:          ctx,
+		Cdc:          cdc,
+		StoreKey:     keyTreasury,
+		Keeper:       keeper,
+		BankKeeper:   bankKeeper,
+		SupplyKeeper: supplyKeeper,
+	}
+}
@@ -0,0 +1,284 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+)
+
+// HandleDisburseVesting schedules a disbursement for every vesting period in the disbursement queue, where they are
+// released by the EndBlocker like any other disbursement or claimed by the recipient. The delay and approvals needed
+// are the ones of a single disbursement of the whole amount, the schedule starts once the delay ended.
+func (k Keeper) HandleDisburseVesting(ctx sdk.Context, operator sdk.AccAddress, recipient sdk.AccAddress, reference string, periods types.VestingPeriods) error {
+	if ! k.IsOperator(ctx, operator) {
+		return types.ErrNotOperator
+	}
+
+	if k.IsDisbursementReferenceSet(ctx, reference) {
+		return types.ErrDuplicateReference
+	}
+
+	if err := periods.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidVestingSchedule, err.Error())
+	}
+
+	dinAmount := periods.TotalAmount()
+
+	delay, requiredApprovals, usageRecordedAt, err := k.scheduleDisbursement(ctx, operator, dinAmount)
+	if err != nil {
+		return err
+	}
+
+	var tranches []types.VestingTranche
+	vestsAt := ctx.BlockTime().Add(delay)
+
+	for _, period := range periods {
+		vestsAt = vestsAt.Add(period.Length)
+
+		scheduledFor := vestsAt
+		for k.HasDisbursementInQueue(ctx, recipient, scheduledFor) {
+			scheduledFor = scheduledFor.Add(time.Millisecond)
+		}
+
+		disbursement := types.NewDisbursement(
+			operator,
+			recipient,
+			period.Amount,
+			scheduledFor,
+			reference,
+			requiredApprovals,
+			k.DisbursementRejectionThreshold(ctx),
+		)
+		disbursement.UsageRecordedAt = usageRecordedAt
+
+		k.InsertDisbursementQueue(ctx, disbursement)
+
+		tranches = append(tranches, types.NewVestingTranche(scheduledFor, period.Amount))
+	}
+
+	k.SetVestingDisbursement(ctx, types.NewVestingDisbursement(operator, recipient, reference, tranches))
+	k.SetDisbursementReferenceAmount(ctx, reference, sdk.ZeroInt())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDisburseVesting,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, dinAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyReference, reference),
+			sdk.NewAttribute(types.AttributeKeyTranches, fmt.Sprintf("%d", len(tranches))),
+			sdk.NewAttribute(types.AttributeKeyRequiredApprovals, fmt.Sprintf("%d", requiredApprovals)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, operator.String()),
+		),
+	})
+
+	return nil
+}
+
+// HandleCancelVestingDisbursement removes the tranches which have not vested yet from the disbursement queue. Vested
+// tranches waiting for approvals stay in the queue.
+func (k Keeper) HandleCancelVestingDisbursement(ctx sdk.Context, manager sdk.AccAddress, recipient sdk.AccAddress, reference string) error {
+	if ! k.IsManager(ctx, manager) {
+		return types.ErrNotManager
+	}
+
+	vesting, found := k.GetVestingDisbursement(ctx, recipient, reference)
+	if ! found {
+		return types.ErrVestingDisbursementNotFound
+	}
+
+	if vesting.IsCancelled() {
+		return types.ErrVestingDisbursementCancelled
+	}
+
+	vesting.CancelledAt = ctx.BlockTime()
+
+	for _, tranche := range vesting.Tranches {
+		if ! tranche.ScheduledFor.After(vesting.CancelledAt) {
+			continue
+		}
+
+		disbursement, found := k.GetDisbursement(ctx, recipient, tranche.ScheduledFor)
+		if found && disbursement.Reference == vesting.Reference {
+			k.RemoveFromDisbursementQueue(ctx, recipient, tranche.ScheduledFor)
+			k.ReleaseDisbursementUsage(ctx, disbursement)
+		}
+	}
+
+	k.SetVestingDisbursement(ctx, vesting)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelVestingDisbursement,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyReference, vesting.Reference),
+			sdk.NewAttribute(types.AttributeKeyCancelledAmount, vesting.CancelledAmount().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, manager.String()),
+		),
+	})
+
+	return nil
+}
+
+// HandleClaimVesting pays out the vested and approved tranches of a vesting disbursement which are still waiting in
+// the disbursement queue, without waiting for the EndBlocker.
+func (k Keeper) HandleClaimVesting(ctx sdk.Context, recipient sdk.AccAddress, reference string) error {
+	vesting, found := k.GetVestingDisbursement(ctx, recipient, reference)
+	if ! found {
+		return types.ErrVestingDisbursementNotFound
+	}
+
+	claimed := sdk.NewCoins()
+
+	for _, tranche := range vesting.Tranches {
+		if tranche.ScheduledFor.After(ctx.BlockTime()) {
+			continue
+		}
+
+		disbursement, found := k.GetDisbursement(ctx, recipient, tranche.ScheduledFor)
+		if ! found || disbursement.Reference != vesting.Reference || ! disbursement.IsApproved() {
+			continue
+		}
+
+		err := k.ExecuteDisbursement(ctx, disbursement.Operator, disbursement)
+		if err != nil {
+			return err
+		}
+
+		k.RemoveFromDisbursementQueue(ctx, recipient, tranche.ScheduledFor)
+
+		claimed = claimed.Add(disbursement.Amount...)
+	}
+
+	if claimed.IsZero() {
+		return types.ErrNothingVested
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimVesting,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, claimed.String()),
+			sdk.NewAttribute(types.AttributeKeyReference, vesting.Reference),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, recipient.String()),
+		),
+	})
+
+	return nil
+}
+
+// approveVestingTranches records the approval of a tranche on the other tranches of its vesting disbursement which are
+// still waiting in the queue. The tranches are scheduled together and share their reference, so they are approved
+// together.
+func (k Keeper) approveVestingTranches(ctx sdk.Context, approver sdk.AccAddress, disbursement types.Disbursement) {
+	vesting, found := k.GetVestingDisbursement(ctx, disbursement.Recipient, disbursement.Reference)
+	if ! found {
+		return
+	}
+
+	for _, tranche := range vesting.Tranches {
+		if tranche.ScheduledFor.Equal(disbursement.ScheduledFor) {
+			continue
+		}
+
+		other, found := k.GetDisbursement(ctx, vesting.Recipient, tranche.ScheduledFor)
+		if ! found || other.Reference != vesting.Reference || other.IsApproved() || other.HasVoted(approver) {
+			continue
+		}
+
+		other.Approvals = append(other.Approvals, approver)
+
+		k.InsertDisbursementQueue(ctx, other)
+	}
+}
+
+// dropVestingTranche marks the tranche of a disbursement removed from the queue on its own as dropped, so that it no
+// longer counts as vested or unvested. Nothing changes for disbursements which are not vesting tranches.
+func (k Keeper) dropVestingTranche(ctx sdk.Context, disbursement types.Disbursement) {
+	vesting, found := k.GetVestingDisbursement(ctx, disbursement.Recipient, disbursement.Reference)
+	if ! found {
+		return
+	}
+
+	for i, tranche := range vesting.Tranches {
+		if tranche.ScheduledFor.Equal(disbursement.ScheduledFor) {
+			vesting.Tranches[i].Dropped = true
+		}
+	}
+
+	k.SetVestingDisbursement(ctx, vesting)
+}
+
+func (k Keeper) GetVestingDisbursement(ctx sdk.Context, recipient sdk.AccAddress, reference string) (types.VestingDisbursement, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.VestingDisbursementKey(recipient, reference))
+	if bz == nil {
+		return types.VestingDisbursement{}, false
+	}
+
+	var vesting types.VestingDisbursement
+	k.cdc.MustUnmarshalBinaryBare(bz, &vesting)
+
+	return vesting, true
+}
+
+func (k Keeper) SetVestingDisbursement(ctx sdk.Context, vesting types.VestingDisbursement) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.VestingDisbursementKey(vesting.Recipient, vesting.Reference), k.cdc.MustMarshalBinaryBare(vesting))
+}
+
+func (k Keeper) iterateVestingDisbursements(ctx sdk.Context, prefix []byte, cb func(vesting types.VestingDisbursement) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vesting types.VestingDisbursement
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vesting)
+
+		if cb(vesting) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateVestingDisbursements(ctx sdk.Context, cb func(vesting types.VestingDisbursement) (stop bool)) {
+	k.iterateVestingDisbursements(ctx, types.VestingDisbursementKeyPrefix, cb)
+}
+
+func (k Keeper) GetVestingDisbursements(ctx sdk.Context) []types.VestingDisbursement {
+	var disbursements []types.VestingDisbursement
+	k.IterateVestingDisbursements(ctx, func(vesting types.VestingDisbursement) (stop bool) {
+		disbursements = append(disbursements, vesting)
+		return false
+	})
+
+	return disbursements
+}
+
+func (k Keeper) GetVestingDisbursementsByRecipient(ctx sdk.Context, recipient sdk.AccAddress) []types.VestingDisbursement {
+	var disbursements []types.VestingDisbursement
+	k.iterateVestingDisbursements(ctx, types.VestingDisbursementsByRecipientKey(recipient), func(vesting types.VestingDisbursement) (stop bool) {
+		disbursements = append(disbursements, vesting)
+		return false
+	})
+
+	return disbursements
+}
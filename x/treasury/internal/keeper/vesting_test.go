@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anathatech/project-anatha/x/treasury/internal/types"
+)
+
+func TestVestedTranchesAreClaimedUntilCancelled(t *testing.T) {
+	input := createTestInput(t)
+	recipient := testAddrs[3]
+
+	periods := types.VestingPeriods{
+		types.NewVestingPeriod(time.Hour, din(1000000000000)),
+		types.NewVestingPeriod(time.Hour, din(1000000000000)),
+		types.NewVestingPeriod(time.Hour, din(1000000000000)),
+	}
+
+	require.NoError(t, input.keeper.HandleDisburseVesting(input.ctx, operatorAddr, recipient, "vesting", periods))
+	require.Equal(t, types.ErrDuplicateReference, input.keeper.HandleDisburseVesting(input.ctx, operatorAddr, recipient, "vesting", periods))
+
+	vesting, found := input.keeper.GetVestingDisbursement(input.ctx, recipient, "vesting")
+	require.True(t, found)
+	require.Len(t, vesting.Tranches, 3)
+	require.Equal(t, input.ctx.BlockTime().Add(time.Hour), vesting.Tranches[0].ScheduledFor)
+	require.Equal(t, input.ctx.BlockTime().Add(3 * time.Hour), vesting.Tranches[2].ScheduledFor)
+
+	require.Equal(t, types.ErrNothingVested, input.keeper.HandleClaimVesting(input.ctx, recipient, "vesting"))
+
+	ctx := input.ctx.WithBlockTime(input.ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, input.keeper.HandleClaimVesting(ctx, recipient, "vesting"))
+	require.False(t, input.bankKeeper.GetCoins(ctx, recipient).IsZero())
+	require.Len(t, input.keeper.GetDisbursements(ctx), 2)
+
+	// claiming again before the next tranche vests has nothing to pay out
+	require.Equal(t, types.ErrNothingVested, input.keeper.HandleClaimVesting(ctx, recipient, "vesting"))
+
+	// cancelling keeps the vested tranche but drops the last one
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.Equal(t, types.ErrNotManager, input.keeper.HandleCancelVestingDisbursement(ctx, operatorAddr, recipient, "vesting"))
+	require.NoError(t, input.keeper.HandleCancelVestingDisbursement(ctx, managerAddr, recipient, "vesting"))
+	require.Equal(t, types.ErrVestingDisbursementCancelled, input.keeper.HandleCancelVestingDisbursement(ctx, managerAddr, recipient, "vesting"))
+
+	vesting, _ = input.keeper.GetVestingDisbursement(ctx, recipient, "vesting")
+	require.Equal(t, din(1000000000000), vesting.CancelledAmount())
+	require.Len(t, input.keeper.GetDisbursements(ctx), 1)
+
+	require.NoError(t, input.keeper.HandleClaimVesting(ctx, recipient, "vesting"))
+	require.Empty(t, input.keeper.GetDisbursements(ctx))
+}
+
+func TestLargeVestingStartsAfterTheRiskAssessment(t *testing.T) {
+	input := createTestInput(t)
+	recipient := testAddrs[3]
+
+	setDisbursementThresholds(input, 2, 1)
+
+	amount := input.keeper.RiskAssessmentAmount(input.ctx)
+	periods := types.VestingPeriods{
+		types.NewVestingPeriod(time.Hour, amount),
+	}
+
+	require.NoError(t, input.keeper.HandleDisburseVesting(input.ctx, operatorAddr, recipient, "delayed", periods))
+
+	vestsAt := input.ctx.BlockTime().Add(input.keeper.RiskAssessmentDuration(input.ctx) + time.Hour)
+
+	vesting, _ := input.keeper.GetVestingDisbursement(input.ctx, recipient, "delayed")
+	require.Equal(t, vestsAt, vesting.Tranches[0].ScheduledFor)
+
+	// the vested tranche still waits for its approvals
+	ctx := input.ctx.WithBlockTime(vestsAt)
+	require.Equal(t, types.ErrNothingVested, input.keeper.HandleClaimVesting(ctx, recipient, "delayed"))
+
+	require.NoError(t, input.keeper.HandleApproveDisbursement(ctx, managerAddr, recipient, vestsAt))
+	require.NoError(t, input.keeper.HandleClaimVesting(ctx, recipient, "delayed"))
+	require.False(t, input.bankKeeper.GetCoins(ctx, recipient).IsZero())
+}
+
+func TestVestingScheduleIsValidated(t *testing.T) {
+	input := createTestInput(t)
+	recipient := testAddrs[3]
+
+	periods := types.VestingPeriods{
+		types.NewVestingPeriod(0, din(1000000000000)),
+	}
+
+	err := input.keeper.HandleDisburseVesting(input.ctx, operatorAddr, recipient, "invalid", periods)
+	require.True(t, types.ErrInvalidVestingSchedule.Is(err), err)
+	require.Equal(t, types.ErrVestingDisbursementNotFound, input.keeper.HandleClaimVesting(input.ctx, recipient, "invalid"))
+}
+
+func TestVestingTranchesAreApprovedTogetherAndDroppedAlone(t *testing.T) {
+	input := createTestInput(t)
+	recipient := testAddrs[3]
+
+	setDisbursementThresholds(input, 2, 1)
+
+	amount := input.keeper.RiskAssessmentAmount(input.ctx)
+	periods := types.VestingPeriods{
+		types.NewVestingPeriod(time.Hour, amount),
+		types.NewVestingPeriod(time.Hour, amount),
+		types.NewVestingPeriod(time.Hour, amount),
+	}
+
+	require.NoError(t, input.keeper.HandleDisburseVesting(input.ctx, operatorAddr, recipient, "tranches", periods))
+	require.Len(t, input.keeper.GetUnapprovedDisbursements(input.ctx), 3)
+
+	vesting, _ := input.keeper.GetVestingDisbursement(input.ctx, recipient, "tranches")
+	first, second, third := vesting.Tranches[0].ScheduledFor, vesting.Tranches[1].ScheduledFor, vesting.Tranches[2].ScheduledFor
+
+	// approving the first tranche approves the others
+	require.NoError(t, input.keeper.HandleApproveDisbursement(input.ctx, managerAddr, recipient, first))
+	require.Empty(t, input.keeper.GetUnapprovedDisbursements(input.ctx))
+	require.Equal(t, types.ErrDisbursementApproved, input.keeper.HandleApproveDisbursement(input.ctx, testAddrs[0], recipient, third))
+
+	// a rejected and a cancelled tranche no longer vest
+	require.NoError(t, input.keeper.HandleRejectDisbursement(input.ctx, testAddrs[0], recipient, second))
+	require.NoError(t, input.keeper.HandleCancelDisbursement(input.ctx, managerAddr, recipient, third))
+
+	vesting, _ = input.keeper.GetVestingDisbursement(input.ctx, recipient, "tranches")
+	require.Equal(t, amount, vesting.VestedAmount(third))
+	require.True(t, vesting.UnvestedAmount(input.ctx.BlockTime()).IsEqual(amount))
+	require.True(t, vesting.CancelledAmount().IsEqual(amount.Add(amount...)))
+	require.False(t, vesting.IsCancelled())
+
+	ctx := input.ctx.WithBlockTime(third)
+	require.NoError(t, input.keeper.HandleClaimVesting(ctx, recipient, "tranches"))
+	require.Empty(t, input.keeper.GetDisbursements(ctx))
+}
@@ -13,9 +13,19 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgDisburseFromEscrow{}, "treasury/DisburseFromEscrow", nil)
 	cdc.RegisterConcrete(MsgRevertFromEscrow{}, "treasury/RevertFromEscrow", nil)
 	cdc.RegisterConcrete(MsgCancelDisbursement{}, "treasury/CancelDisbursement", nil)
+	cdc.RegisterConcrete(MsgApproveDisbursement{}, "treasury/ApproveDisbursement", nil)
+	cdc.RegisterConcrete(MsgRejectDisbursement{}, "treasury/RejectDisbursement", nil)
+	cdc.RegisterConcrete(MsgRetryDisbursement{}, "treasury/RetryDisbursement", nil)
+	cdc.RegisterConcrete(MsgCancelFailedDisbursement{}, "treasury/CancelFailedDisbursement", nil)
 	cdc.RegisterConcrete(MsgCreateSellOrder{}, "treasury/CreateSellOrder", nil)
 	cdc.RegisterConcrete(MsgCreateBuyOrder{}, "treasury/CreateBuyOrder", nil)
+	cdc.RegisterConcrete(MsgDisburseVesting{}, "treasury/DisburseVesting", nil)
+	cdc.RegisterConcrete(MsgCancelVestingDisbursement{}, "treasury/CancelVestingDisbursement", nil)
+	cdc.RegisterConcrete(MsgClaimVesting{}, "treasury/ClaimVesting", nil)
 	cdc.RegisterConcrete(MsgSwap{}, "treasury/Swap", nil)
+	cdc.RegisterConcrete(MsgClaimSwap{}, "treasury/ClaimSwap", nil)
+	cdc.RegisterConcrete(MsgAttestSwap{}, "treasury/AttestSwap", nil)
+	cdc.RegisterConcrete(MsgRejectSwap{}, "treasury/RejectSwap", nil)
 
 	cdc.RegisterConcrete(AddBuyBackLiquidityProposal{}, "treasury/AddBuyBackLiquidityProposal", nil)
 	cdc.RegisterConcrete(RemoveBuyBackLiquidityProposal{}, "treasury/RemoveBuyBackLiquidityProposal", nil)
@@ -0,0 +1,380 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	BondingCurveLinear 		= "linear"
+	BondingCurveExponential = "exponential"
+	BondingCurvePiecewise 	= "piecewise"
+)
+
+var (
+	// MaxExponentialGrowth keeps exponential prices representable over the whole treasury supply.
+	MaxExponentialGrowth = sdk.NewDecWithPrec(11, 1)
+
+	// MaxBondingCurveStages is the number of stages the target supply can be split into. Every curve has to price the
+	// stages below it at no more than MaxBondingCurvePrice.
+	MaxBondingCurveStages = sdk.NewInt(1000)
+	MaxBondingCurvePrice = sdk.NewIntWithDecimal(1, 30)
+)
+
+// Curve returns the DIN price of a single PIN in the given stage.
+type Curve interface {
+	PriceAt(stage sdk.Int) sdk.Int
+	Validate() error
+}
+
+// CurveStep sets the price of the stages starting at FromStage up to the FromStage of the next step.
+type CurveStep struct {
+	FromStage 	sdk.Int `json:"from_stage" yaml:"from_stage"`
+	Price 		sdk.Int `json:"price" yaml:"price"`
+}
+
+func NewCurveStep(fromStage sdk.Int, price sdk.Int) CurveStep {
+	return CurveStep{
+		FromStage: fromStage,
+		Price: price,
+	}
+}
+
+func (s CurveStep) String() string {
+	return fmt.Sprintf(`FromStage: %s, Price: %s`, s.FromStage, s.Price)
+}
+
+// BondingCurve selects and parameterises the curve the treasury sells PIN on. Only the fields of the selected Type
+// are used:
+//  - linear: Base + Slope * stage
+//  - exponential: Base * Growth ^ stage
+//  - piecewise: price of the last step starting at or before the stage
+type BondingCurve struct {
+	Type 	string 		`json:"type" yaml:"type"`
+	Base 	sdk.Int 	`json:"base" yaml:"base"`
+	Slope 	sdk.Int 	`json:"slope" yaml:"slope"`
+	Growth 	sdk.Dec 	`json:"growth" yaml:"growth"`
+	Steps 	[]CurveStep `json:"steps" yaml:"steps"`
+}
+
+func NewLinearBondingCurve(base sdk.Int, slope sdk.Int) BondingCurve {
+	return BondingCurve{
+		Type: BondingCurveLinear,
+		Base: base,
+		Slope: slope,
+		Growth: sdk.ZeroDec(),
+		Steps: []CurveStep{},
+	}
+}
+
+func NewExponentialBondingCurve(base sdk.Int, growth sdk.Dec) BondingCurve {
+	return BondingCurve{
+		Type: BondingCurveExponential,
+		Base: base,
+		Slope: sdk.ZeroInt(),
+		Growth: growth,
+		Steps: []CurveStep{},
+	}
+}
+
+func NewPiecewiseBondingCurve(steps []CurveStep) BondingCurve {
+	return BondingCurve{
+		Type: BondingCurvePiecewise,
+		Base: sdk.ZeroInt(),
+		Slope: sdk.ZeroInt(),
+		Growth: sdk.ZeroDec(),
+		Steps: steps,
+	}
+}
+
+// DefaultBondingCurve prices stage n at n + 1, which is $0.0000000001 per PIN in the first stage.
+func DefaultBondingCurve() BondingCurve {
+	return NewLinearBondingCurve(sdk.OneInt(), sdk.OneInt())
+}
+
+func (c BondingCurve) String() string {
+	switch c.Type {
+		case BondingCurveLinear:
+			return fmt.Sprintf(`Type: %s, Base: %s, Slope: %s`, c.Type, c.Base, c.Slope)
+
+		case BondingCurveExponential:
+			return fmt.Sprintf(`Type: %s, Base: %s, Growth: %s`, c.Type, c.Base, c.Growth)
+
+		case BondingCurvePiecewise:
+			steps := make([]string, len(c.Steps))
+			for i, step := range c.Steps {
+				steps[i] = step.String()
+			}
+
+			return fmt.Sprintf("Type: %s, Steps:\n    %s", c.Type, strings.Join(steps, "\n    "))
+
+		default:
+			return fmt.Sprintf(`Type: %s`, c.Type)
+	}
+}
+
+// Curve returns the implementation of the selected curve type.
+func (c BondingCurve) Curve() Curve {
+	switch c.Type {
+		case BondingCurveLinear:
+			return linearCurve{c.Base, c.Slope}
+
+		case BondingCurveExponential:
+			return exponentialCurve{c.Base, c.Growth}
+
+		case BondingCurvePiecewise:
+			return piecewiseCurve(c.Steps)
+
+		default:
+			return nil
+	}
+}
+
+// PriceAt returns the DIN price of a single PIN in the given stage.
+func (c BondingCurve) PriceAt(stage sdk.Int) sdk.Int {
+	return c.Curve().PriceAt(stage)
+}
+
+// Validate checks the curve parameters and that the price of the last stage stays within MaxBondingCurvePrice.
+func (c BondingCurve) Validate() error {
+	curve := c.Curve()
+	if curve == nil {
+		return fmt.Errorf("unknown bonding curve type: %s", c.Type)
+	}
+
+	if err := curve.Validate(); err != nil {
+		return err
+	}
+
+	if exponential, ok := curve.(exponentialCurve); ok {
+		// the price is checked step by step, Power would overflow before the limit can be compared
+		price := exponential.base.ToDec()
+		max := MaxBondingCurvePrice.ToDec()
+
+		for stage := int64(1); stage < MaxBondingCurveStages.Int64(); stage++ {
+			price = price.Mul(exponential.growth)
+			if price.GT(max) {
+				return fmt.Errorf("exponential bonding curve price exceeds %s at stage %d", MaxBondingCurvePrice, stage)
+			}
+		}
+
+		return nil
+	}
+
+	if price := curve.PriceAt(MaxBondingCurveStages.SubRaw(1)); price.GT(MaxBondingCurvePrice) {
+		return fmt.Errorf("bonding curve price exceeds %s: %s", MaxBondingCurvePrice, price)
+	}
+
+	return nil
+}
+
+// ValidateStageCount checks that the target supply is not split into more than MaxBondingCurveStages stages.
+func ValidateStageCount(targetSupply sdk.Int, coinsPerStage sdk.Int) error {
+	if ! coinsPerStage.IsPositive() {
+		return fmt.Errorf("coins per stage must be positive: %s", coinsPerStage)
+	}
+
+	stages := targetSupply.Add(coinsPerStage).SubRaw(1).Quo(coinsPerStage)
+	if stages.GT(MaxBondingCurveStages) {
+		return fmt.Errorf("target supply %s is split into %s stages, more than %s", targetSupply, stages, MaxBondingCurveStages)
+	}
+
+	return nil
+}
+
+// Stage returns the stage the treasury is in after distributing the given amount of PIN.
+func (c BondingCurve) Stage(distributed sdk.Int, coinsPerStage sdk.Int) sdk.Int {
+	return distributed.Quo(coinsPerStage)
+}
+
+// DinForPin returns the DIN needed to buy pinAmount from the treasury after distributed PIN were already sold.
+func (c BondingCurve) DinForPin(distributed sdk.Int, coinsPerStage sdk.Int, pinAmount sdk.Int) sdk.Int {
+	dinAmount := sdk.ZeroInt()
+
+	if ! pinAmount.IsPositive() {
+		return dinAmount
+	}
+
+	stage := c.Stage(distributed, coinsPerStage)
+	remaining := coinsPerStage.Sub(distributed.Mod(coinsPerStage))
+
+	for ! pinAmount.IsZero() {
+		stagePrice := c.PriceAt(stage)
+
+		if remaining.LT(pinAmount) {
+			// we are clearing out a stage and transitioning to the next
+
+			dinAmount = dinAmount.Add(remaining.Mul(stagePrice))
+			pinAmount = pinAmount.Sub(remaining)
+		} else {
+			// we have enough liquidity in the current stage
+
+			dinAmount = dinAmount.Add(pinAmount.Mul(stagePrice))
+			pinAmount = sdk.ZeroInt()
+		}
+
+		stage = stage.Add(sdk.OneInt())
+		remaining = coinsPerStage
+	}
+
+	return dinAmount
+}
+
+// DinForPinSold returns the DIN the treasury took for the last pinAmount PIN it sold before distributed. PIN beyond
+// the distributed amount are priced at the first stage. Selling PIN back down the curve never pays more than buying
+// them cost, however many stages the purchase crossed.
+func (c BondingCurve) DinForPinSold(distributed sdk.Int, coinsPerStage sdk.Int, pinAmount sdk.Int) sdk.Int {
+	if ! pinAmount.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	if pinAmount.GT(distributed) {
+		belowFirstStage := pinAmount.Sub(distributed).Mul(c.PriceAt(sdk.ZeroInt()))
+
+		return c.DinForPin(sdk.ZeroInt(), coinsPerStage, distributed).Add(belowFirstStage)
+	}
+
+	return c.DinForPin(distributed.Sub(pinAmount), coinsPerStage, pinAmount)
+}
+
+// PinForDin returns the PIN bought from the treasury for dinAmount after distributed PIN were already sold.
+// Fractions of a PIN are rounded down, so the DIN price of the result never exceeds dinAmount.
+func (c BondingCurve) PinForDin(distributed sdk.Int, coinsPerStage sdk.Int, dinAmount sdk.Int) sdk.Int {
+	pinAmount := sdk.ZeroInt()
+
+	if ! dinAmount.IsPositive() {
+		return pinAmount
+	}
+
+	stage := c.Stage(distributed, coinsPerStage)
+	remainingPin := coinsPerStage.Sub(distributed.Mod(coinsPerStage))
+
+	for ! dinAmount.IsZero() {
+		stagePrice := c.PriceAt(stage)
+
+		remainingDinPrice := remainingPin.Mul(stagePrice)
+
+		if remainingDinPrice.LT(dinAmount) {
+			// we are clearing out a stage and transitioning to the next
+
+			pinAmount = pinAmount.Add(remainingPin)
+			dinAmount = dinAmount.Sub(remainingDinPrice)
+		} else {
+			// we have enough liquidity in the current stage
+
+			pinAmount = pinAmount.Add(dinAmount.Quo(stagePrice))
+			dinAmount = sdk.ZeroInt()
+		}
+
+		stage = stage.Add(sdk.OneInt())
+		remainingPin = coinsPerStage
+	}
+
+	return pinAmount
+}
+
+type linearCurve struct {
+	base 	sdk.Int
+	slope 	sdk.Int
+}
+
+func (c linearCurve) PriceAt(stage sdk.Int) sdk.Int {
+	return c.base.Add(c.slope.Mul(stage))
+}
+
+func (c linearCurve) Validate() error {
+	if isNilInt(c.base) || ! c.base.IsPositive() {
+		return fmt.Errorf("linear bonding curve base must be positive: %s", c.base)
+	}
+
+	if isNilInt(c.slope) || c.slope.IsNegative() {
+		return fmt.Errorf("linear bonding curve slope must not be negative: %s", c.slope)
+	}
+
+	return nil
+}
+
+type exponentialCurve struct {
+	base 	sdk.Int
+	growth 	sdk.Dec
+}
+
+func (c exponentialCurve) PriceAt(stage sdk.Int) sdk.Int {
+	return c.base.ToDec().Mul(c.growth.Power(stage.Uint64())).TruncateInt()
+}
+
+func (c exponentialCurve) Validate() error {
+	if isNilInt(c.base) || ! c.base.IsPositive() {
+		return fmt.Errorf("exponential bonding curve base must be positive: %s", c.base)
+	}
+
+	if c.growth.IsNil() || c.growth.LT(sdk.OneDec()) || c.growth.GT(MaxExponentialGrowth) {
+		return fmt.Errorf("exponential bonding curve growth must be between 1 and %s: %s", MaxExponentialGrowth, c.growth)
+	}
+
+	return nil
+}
+
+type piecewiseCurve []CurveStep
+
+func (c piecewiseCurve) PriceAt(stage sdk.Int) sdk.Int {
+	price := c[0].Price
+
+	for _, step := range c {
+		if step.FromStage.GT(stage) {
+			break
+		}
+
+		price = step.Price
+	}
+
+	return price
+}
+
+// Validate makes sure the steps cover every stage and the price never goes down.
+func (c piecewiseCurve) Validate() error {
+	if len(c) == 0 {
+		return fmt.Errorf("piecewise bonding curve needs at least one step")
+	}
+
+	if isNilInt(c[0].FromStage) || ! c[0].FromStage.IsZero() {
+		return fmt.Errorf("first piecewise bonding curve step must start at stage 0: %s", c[0].FromStage)
+	}
+
+	for i, step := range c {
+		if isNilInt(step.Price) || ! step.Price.IsPositive() {
+			return fmt.Errorf("piecewise bonding curve price must be positive: %s", step.Price)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		previous := c[i - 1]
+
+		if isNilInt(step.FromStage) || step.FromStage.LTE(previous.FromStage) {
+			return fmt.Errorf("piecewise bonding curve steps must be ordered by strictly increasing stage: %s", step.FromStage)
+		}
+
+		if step.Price.LT(previous.Price) {
+			return fmt.Errorf("piecewise bonding curve price must not decrease: %s", step.Price)
+		}
+	}
+
+	return nil
+}
+
+func validateBondingCurve(i interface{}) error {
+	v, ok := i.(BondingCurve)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func isNilInt(i sdk.Int) bool {
+	return i == sdk.Int{}
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func testBondingCurves() []BondingCurve {
+	return []BondingCurve{
+		DefaultBondingCurve(),
+		NewLinearBondingCurve(sdk.NewInt(7), sdk.ZeroInt()),
+		NewLinearBondingCurve(sdk.NewInt(3), sdk.NewInt(11)),
+		NewExponentialBondingCurve(sdk.NewInt(5), sdk.NewDecWithPrec(105, 2)),
+		NewExponentialBondingCurve(sdk.OneInt(), sdk.OneDec()),
+		NewPiecewiseBondingCurve([]CurveStep{
+			NewCurveStep(sdk.ZeroInt(), sdk.NewInt(2)),
+			NewCurveStep(sdk.NewInt(3), sdk.NewInt(9)),
+			NewCurveStep(sdk.NewInt(10), sdk.NewInt(40)),
+		}),
+	}
+}
+
+func TestBondingCurveValidate(t *testing.T) {
+	for _, curve := range testBondingCurves() {
+		require.NoError(t, curve.Validate(), curve.String())
+	}
+
+	invalid := []BondingCurve{
+		{Type: "quadratic"},
+		NewLinearBondingCurve(sdk.ZeroInt(), sdk.OneInt()),
+		NewLinearBondingCurve(sdk.OneInt(), sdk.NewInt(-1)),
+		NewExponentialBondingCurve(sdk.OneInt(), sdk.NewDecWithPrec(9, 1)),
+		NewExponentialBondingCurve(sdk.OneInt(), sdk.NewDec(2)),
+		NewPiecewiseBondingCurve([]CurveStep{}),
+		NewPiecewiseBondingCurve([]CurveStep{NewCurveStep(sdk.OneInt(), sdk.OneInt())}),
+		NewPiecewiseBondingCurve([]CurveStep{
+			NewCurveStep(sdk.ZeroInt(), sdk.NewInt(5)),
+			NewCurveStep(sdk.NewInt(2), sdk.NewInt(4)),
+		}),
+		NewPiecewiseBondingCurve([]CurveStep{
+			NewCurveStep(sdk.ZeroInt(), sdk.NewInt(5)),
+			NewCurveStep(sdk.ZeroInt(), sdk.NewInt(6)),
+		}),
+	}
+
+	for _, curve := range invalid {
+		require.Error(t, curve.Validate(), curve.String())
+	}
+}
+
+func TestDefaultBondingCurvePrice(t *testing.T) {
+	curve := DefaultBondingCurve()
+
+	for stage := int64(0); stage < 100; stage++ {
+		require.Equal(t, sdk.NewInt(stage + 1), curve.PriceAt(sdk.NewInt(stage)))
+	}
+}
+
+func TestPiecewiseBondingCurvePrice(t *testing.T) {
+	curve := testBondingCurves()[5]
+
+	require.Equal(t, sdk.NewInt(2), curve.PriceAt(sdk.ZeroInt()))
+	require.Equal(t, sdk.NewInt(2), curve.PriceAt(sdk.NewInt(2)))
+	require.Equal(t, sdk.NewInt(9), curve.PriceAt(sdk.NewInt(3)))
+	require.Equal(t, sdk.NewInt(40), curve.PriceAt(sdk.NewInt(1000)))
+}
+
+// Converting DIN to PIN and back at the same point of the curve must never return more DIN than was paid, and the
+// other way around for PIN. The round trip through buy and sell orders is tested in the keeper.
+func TestBondingCurveConversionsRoundDown(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	for _, curve := range testBondingCurves() {
+		for i := 0; i < 500; i++ {
+			coinsPerStage := sdk.NewInt(r.Int63n(1000000) + 1)
+			distributed := sdk.NewInt(r.Int63n(coinsPerStage.Int64() * 20))
+
+			dinAmount := sdk.NewInt(r.Int63n(coinsPerStage.Int64() * 100))
+			pinAmount := curve.PinForDin(distributed, coinsPerStage, dinAmount)
+			require.True(t, curve.DinForPin(distributed, coinsPerStage, pinAmount).LTE(dinAmount), curve.String())
+
+			pinAmount = sdk.NewInt(r.Int63n(coinsPerStage.Int64() * 5))
+			dinAmount = curve.DinForPin(distributed, coinsPerStage, pinAmount)
+			require.True(t, curve.PinForDin(distributed, coinsPerStage, dinAmount).LTE(pinAmount), curve.String())
+		}
+	}
+}
+
+// Selling the PIN bought at any point of the curve back down the curve never returns more DIN than was paid.
+func TestBondingCurveSoldBelowBought(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+
+	for _, curve := range testBondingCurves() {
+		for i := 0; i < 500; i++ {
+			coinsPerStage := sdk.NewInt(r.Int63n(1000000) + 1)
+			distributed := sdk.NewInt(r.Int63n(coinsPerStage.Int64() * 20))
+
+			pinAmount := sdk.NewInt(r.Int63n(coinsPerStage.Int64() * 50))
+			dinAmount := curve.DinForPin(distributed, coinsPerStage, pinAmount)
+
+			sold := curve.DinForPinSold(distributed.Add(pinAmount), coinsPerStage, pinAmount)
+			require.True(t, sold.LTE(dinAmount), curve.String())
+		}
+	}
+}
+
+func TestBondingCurveValidateBounds(t *testing.T) {
+	require.Error(t, NewExponentialBondingCurve(sdk.OneInt(), MaxExponentialGrowth).Validate())
+	require.Error(t, NewLinearBondingCurve(sdk.OneInt(), MaxBondingCurvePrice).Validate())
+	require.Error(t, NewPiecewiseBondingCurve([]CurveStep{NewCurveStep(sdk.ZeroInt(), MaxBondingCurvePrice.AddRaw(1))}).Validate())
+
+	require.NoError(t, ValidateStageCount(sdk.NewInt(1000), sdk.OneInt()))
+	require.Error(t, ValidateStageCount(sdk.NewInt(1001), sdk.OneInt()))
+	require.Error(t, ValidateStageCount(sdk.NewInt(1000), sdk.ZeroInt()))
+}
+
+func TestBondingCurveZeroAmounts(t *testing.T) {
+	curve := DefaultBondingCurve()
+
+	require.True(t, curve.DinForPin(sdk.ZeroInt(), sdk.NewInt(10), sdk.ZeroInt()).IsZero())
+	require.True(t, curve.PinForDin(sdk.ZeroInt(), sdk.NewInt(10), sdk.ZeroInt()).IsZero())
+	require.True(t, curve.PinForDin(sdk.ZeroInt(), sdk.NewInt(10), sdk.NewInt(-5)).IsZero())
+}
@@ -14,4 +14,22 @@ var (
 	ErrDuplicateReference = sdkerrors.Register(ModuleName, 112, "Reference already used")
 	ErrEscrowRevertAmountTooBig = sdkerrors.Register(ModuleName, 113, "Escrow revert amount too big")
 	ErrDisbursementNotScheduled = sdkerrors.Register(ModuleName, 114, "Disbursement not scheduled")
+	ErrNotApprover = sdkerrors.Register(ModuleName, 115, "No Manager or Operator permissions to approve or reject the disbursement.")
+	ErrAlreadyVoted = sdkerrors.Register(ModuleName, 116, "Disbursement already approved or rejected by this address")
+	ErrDisbursementApproved = sdkerrors.Register(ModuleName, 117, "Disbursement already has enough approvals")
+	ErrDisbursementLimitExceeded = sdkerrors.Register(ModuleName, 118, "Disbursement rate limit exceeded")
+	ErrFailedDisbursementNotFound = sdkerrors.Register(ModuleName, 119, "Failed disbursement not found")
+	ErrInvalidBondingCurve = sdkerrors.Register(ModuleName, 120, "Invalid bonding curve")
+	ErrSlippageExceeded = sdkerrors.Register(ModuleName, 121, "Order price moved beyond the accepted limit")
+	// 122 to 124 are registered by the order book, see x/treasury/orderbook/types
+	ErrSwapClaimNotFound = sdkerrors.Register(ModuleName, 125, "Swap claim not found")
+	ErrSwapClaimExists = sdkerrors.Register(ModuleName, 126, "Swap claim already submitted for this reference")
+	ErrSwapClaimMismatch = sdkerrors.Register(ModuleName, 127, "Attestation does not match the recipient and amount of the swap claim")
+	ErrInvalidVestingSchedule = sdkerrors.Register(ModuleName, 128, "Invalid vesting schedule")
+	ErrVestingDisbursementNotFound = sdkerrors.Register(ModuleName, 129, "Vesting disbursement not found")
+	ErrVestingDisbursementCancelled = sdkerrors.Register(ModuleName, 130, "Vesting disbursement already cancelled")
+	ErrDisbursementThresholdUnreachable = sdkerrors.Register(ModuleName, 131, "Not enough managers and operators to reach the disbursement approval or rejection threshold")
+	ErrSwapThresholdUnreachable = sdkerrors.Register(ModuleName, 132, "Not enough operators to reach the swap attestation threshold")
+	ErrNothingVested = sdkerrors.Register(ModuleName, 133, "No vested and approved tranches to claim")
+	ErrSwapDisabled = sdkerrors.Register(ModuleName, 134, "Swaps are released through attested swap claims")
 )
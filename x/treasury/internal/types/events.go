@@ -8,10 +8,22 @@ const (
 	EventTypeAddOperator 		= "add_operator"
 	EventTypeRemoveOperator 	= "remove_operator"
 	EventTypeCancelDisbursement	= "cancel_disbursement"
+	EventTypeApproveDisbursement = "approve_disbursement"
+	EventTypeRejectDisbursement	= "reject_disbursement"
+	EventTypeExpireDisbursement	= "expire_disbursement"
+	EventTypeDisbursementFailed	= "disbursement_failed"
+	EventTypeRetryDisbursement	= "retry_disbursement"
+	EventTypeCancelFailedDisbursement = "cancel_failed_disbursement"
 	EventTypeCreateSellOrder	= "create_sell_order"
 	EventTypeCreateBuyOrder		= "create_buy_order"
 	EventTypeTransfer			= "transfer_to_distribution_module"
 	EventTypeSwap				= "swap"
+	EventTypeDisburseVesting	= "disburse_vesting"
+	EventTypeCancelVestingDisbursement = "cancel_vesting_disbursement"
+	EventTypeClaimVesting = "claim_vesting"
+	EventTypeClaimSwap			= "claim_swap"
+	EventTypeAttestSwap			= "attest_swap"
+	EventTypeRejectSwap			= "reject_swap"
 
 	EventTypeAddBuyBackLiquidity = "AddBuyBackLiquidity"
 	EventTypeRemoveBuyBackLiquidity = "RemoveBuyBackLiquidity"
@@ -19,6 +31,7 @@ const (
 	EventTypeTransferFromDistributionProfitsToBuyBackLiquidity = "TransferFromDistributionProfitsToBuyBackLiquidity"
 	EventTypeTransferFromTreasuryToSwapEscrow = "TransferFromTreasuryToSwapEscrow"
 	EventTypeTransferSwapEscrowToBuyBack = "TransferSwapEscrowToBuyBack"
+	EventTypeSetBondingCurve = "SetBondingCurve"
 
 
 	AttributeKeySender				= "sender"
@@ -30,8 +43,19 @@ const (
 	AttributeKeyPinAmount			= "pin_amount"
 	AttributeKeyDinAmount			= "din_amount"
 	AttributeKeyEscrowRemainder 	= "escrow_remainder"
+	AttributeKeyApprovals 			= "approvals"
+	AttributeKeyRejections 			= "rejections"
+	AttributeKeyRequiredApprovals 	= "required_approvals"
+	AttributeKeyRequiredRejections 	= "required_rejections"
+	AttributeKeyReason 				= "reason"
 	AttributeKeyTitle					= "title"
 	AttributeKeyDescription				= "description"
+	AttributeKeyBondingCurve			= "bonding_curve"
+	AttributeKeyCoinsPerStage			= "coins_per_stage"
+	AttributeKeyTranches				= "tranches"
+	AttributeKeyCancelledAmount			= "cancelled_amount"
+	AttributeKeyAttestations			= "attestations"
+	AttributeKeyRequiredAttestations	= "required_attestations"
 
 	AttributeValueModule = ModuleName
 )
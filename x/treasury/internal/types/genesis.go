@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"github.com/anathatech/project-anatha/config"
+	orderbook "github.com/anathatech/project-anatha/x/treasury/orderbook/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,16 +17,36 @@ type GenesisState struct {
 	DisbursementQueue []Disbursement `json:"disbursement_queue" yaml:"disbursement_queue"`
 
 	DisbursementReferences []ReferenceAmountInfo `json:"disbursement_references" yaml:"disbursement_references"`
+
+	DisbursementUsage []DisbursementUsage `json:"disbursement_usage" yaml:"disbursement_usage"`
+
+	FailedDisbursements []FailedDisbursement `json:"failed_disbursements" yaml:"failed_disbursements"`
+
+	OrderBook orderbook.GenesisState `json:"order_book" yaml:"order_book"`
+
+	Ledger []LedgerEntry `json:"ledger" yaml:"ledger"`
+	NextLedgerEntryID uint64 `json:"next_ledger_entry_id" yaml:"next_ledger_entry_id"`
+
+	SwapClaims []SwapClaim `json:"swap_claims" yaml:"swap_claims"`
+
+	VestingDisbursements []VestingDisbursement `json:"vesting_disbursements" yaml:"vesting_disbursements"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(treasury Treasury, params Params, operators []sdk.AccAddress, disbursements []Disbursement, references []ReferenceAmountInfo) GenesisState {
+func NewGenesisState(treasury Treasury, params Params, operators []sdk.AccAddress, disbursements []Disbursement, references []ReferenceAmountInfo, usage []DisbursementUsage, failedDisbursements []FailedDisbursement, orderBook orderbook.GenesisState, ledger []LedgerEntry, nextLedgerEntryID uint64, swapClaims []SwapClaim, vestingDisbursements []VestingDisbursement) GenesisState {
 	return GenesisState{
 		Treasury: 	treasury,
 		Params: 	params,
 		Operators: 	operators,
 		DisbursementQueue: disbursements,
 		DisbursementReferences: references,
+		DisbursementUsage: usage,
+		FailedDisbursements: failedDisbursements,
+		OrderBook: orderBook,
+		Ledger: ledger,
+		NextLedgerEntryID: nextLedgerEntryID,
+		SwapClaims: swapClaims,
+		VestingDisbursements: vestingDisbursements,
 	}
 }
 
@@ -34,6 +58,13 @@ func DefaultGenesisState() GenesisState {
 		Operators: 	DefaultOperators(),
 		DisbursementQueue: []Disbursement{},
 		DisbursementReferences: []ReferenceAmountInfo{},
+		DisbursementUsage: []DisbursementUsage{},
+		FailedDisbursements: []FailedDisbursement{},
+		OrderBook: orderbook.DefaultGenesisState(),
+		Ledger: []LedgerEntry{},
+		NextLedgerEntryID: 1,
+		SwapClaims: []SwapClaim{},
+		VestingDisbursements: []VestingDisbursement{},
 	}
 }
 
@@ -43,5 +74,35 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := ValidateStageCount(data.Treasury.TargetSupply.AmountOf(config.DefaultDenom), data.Treasury.CoinsPerStage.AmountOf(config.DefaultDenom)); err != nil {
+		return err
+	}
+
+	if err := data.Params.ValidateDisbursementThresholds(CountDisbursementVoters(data.Params.Managers, data.Operators)); err != nil {
+		return err
+	}
+
+	if err := orderbook.ValidateGenesis(data.OrderBook); err != nil {
+		return err
+	}
+
+	for _, entry := range data.Ledger {
+		if entry.ID >= data.NextLedgerEntryID {
+			return fmt.Errorf("invalid ledger entry %d: id must be lower than the next ledger entry id %d", entry.ID, data.NextLedgerEntryID)
+		}
+	}
+
+	for _, claim := range data.SwapClaims {
+		if claim.Reference == "" || claim.Recipient.Empty() {
+			return fmt.Errorf("invalid swap claim: missing reference or recipient: %s", claim)
+		}
+	}
+
+	for _, disbursement := range data.VestingDisbursements {
+		if disbursement.Recipient.Empty() || len(disbursement.Tranches) == 0 {
+			return fmt.Errorf("invalid vesting disbursement: missing recipient or tranches: %s", disbursement)
+		}
+	}
+
 	return nil
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"strings"
 	"time"
 )
 
@@ -12,6 +13,17 @@ var (
 	DisbursementQueueKeyPrefix = []byte{0x12}
 
 	DisbursementReferenceKeyPrefix = []byte{0x15}
+	DisbursementUsageKeyPrefix     = []byte{0x16}
+	FailedDisbursementKeyPrefix    = []byte{0x17}
+
+	// 0x18 to 0x1B are used by the order book, see x/treasury/orderbook/types
+
+	LedgerKeyPrefix        = []byte{0x1C}
+	NextLedgerEntryIDKey   = []byte{0x1D}
+
+	SwapClaimKeyPrefix = []byte{0x1E}
+
+	VestingDisbursementKeyPrefix = []byte{0x1F}
 
 	StatusPresent = []byte{0x01}
 )
@@ -59,8 +71,17 @@ func DisbursementQueueKey(address sdk.AccAddress, endTime time.Time) []byte {
 	return append(DisbursementByTimeKey(endTime), address...)
 }
 
+func FailedDisbursementKey(address sdk.AccAddress, scheduledFor time.Time) []byte {
+	return append(append(FailedDisbursementKeyPrefix, sdk.FormatTimeBytes(scheduledFor)...), address...)
+}
+
+// NormalizeReference returns the form a reference is stored under, references are case insensitive.
+func NormalizeReference(reference string) string {
+	return strings.ToLower(reference)
+}
+
 func GetDisbursementReferenceKey(reference string) []byte {
-	return append(DisbursementReferenceKeyPrefix, []byte(reference)...)
+	return append(DisbursementReferenceKeyPrefix, []byte(NormalizeReference(reference))...)
 }
 
 func GetDisbursementReferenceIteratorKey() []byte {
@@ -69,4 +90,42 @@ func GetDisbursementReferenceIteratorKey() []byte {
 
 func SplitDisbursementReferenceKey(key []byte) (string) {
 	return string(key[1:])
-}
\ No newline at end of file
+}
+
+func DisbursementUsageByTimeKey(disbursedAt time.Time) []byte {
+	return append(DisbursementUsageKeyPrefix, sdk.FormatTimeBytes(disbursedAt)...)
+}
+
+func DisbursementUsageKey(operator sdk.AccAddress, disbursedAt time.Time) []byte {
+	return append(DisbursementUsageByTimeKey(disbursedAt), operator...)
+}
+
+func SplitDisbursementUsageKey(key []byte) (sdk.AccAddress, time.Time) {
+	disbursedAt, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	return key[1+lenTime:], disbursedAt
+}
+
+// LedgerByHeightKey returns the prefix of the ledger entries recorded at the given height.
+func LedgerByHeightKey(height int64) []byte {
+	return append(LedgerKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func LedgerKey(height int64, id uint64) []byte {
+	return append(LedgerByHeightKey(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetSwapClaimKey(reference string) []byte {
+	return append(SwapClaimKeyPrefix, []byte(NormalizeReference(reference))...)
+}
+
+func VestingDisbursementsByRecipientKey(recipient sdk.AccAddress) []byte {
+	return append(VestingDisbursementKeyPrefix, recipient...)
+}
+
+func VestingDisbursementKey(recipient sdk.AccAddress, reference string) []byte {
+	return append(VestingDisbursementsByRecipientKey(recipient), []byte(NormalizeReference(reference))...)
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	LedgerCategoryMint 			= "mint"
+	LedgerCategoryBurn 			= "burn"
+	LedgerCategoryBuyOrder 		= "buy_order"
+	LedgerCategorySellOrder 	= "sell_order"
+	LedgerCategoryDisbursement 	= "disbursement"
+	LedgerCategoryEscrow 		= "escrow"
+	LedgerCategorySwap 			= "swap"
+	LedgerCategoryLimitOrder 	= "limit_order"
+	LedgerCategoryProposal 		= "proposal"
+
+	// MaxLedgerEntriesPrunedPerBlock bounds the work the ledger pruning does in a single end block.
+	MaxLedgerEntriesPrunedPerBlock = 1000
+)
+
+// LedgerEntry records a single movement of funds from or to one of the treasury module accounts. From and To hold
+// either a module account name or an account address, the source of minted and the destination of burned coins is
+// left empty.
+type LedgerEntry struct {
+	ID 			uint64 		`json:"id" yaml:"id"`
+	Height 		int64 		`json:"height" yaml:"height"`
+	Time 		time.Time 	`json:"time" yaml:"time"`
+	Category 	string 		`json:"category" yaml:"category"`
+	Reference 	string 		`json:"reference" yaml:"reference"`
+	From 		string 		`json:"from" yaml:"from"`
+	To 			string 		`json:"to" yaml:"to"`
+	Amount 		sdk.Coins 	`json:"amount" yaml:"amount"`
+}
+
+func NewLedgerEntry(id uint64, height int64, time time.Time, category string, reference string, from string, to string, amount sdk.Coins) LedgerEntry {
+	return LedgerEntry{
+		ID: id,
+		Height: height,
+		Time: time,
+		Category: category,
+		Reference: reference,
+		From: from,
+		To: to,
+		Amount: amount,
+	}
+}
+
+func (e LedgerEntry) String() string {
+	return fmt.Sprintf(`
+	ID: %d
+	Height: %d
+	Time: %s
+	Category: %s
+	Reference: %s
+	From: %s
+	To: %s
+	Amount: %s
+	`, e.ID, e.Height, e.Time, e.Category, e.Reference, e.From, e.To, e.Amount)
+}
+
+// CSVHeader returns the column names of CSVRecord.
+func (e LedgerEntry) CSVHeader() []string {
+	return []string{"id", "height", "time", "category", "reference", "from", "to", "amount"}
+}
+
+// CSVRecord returns the entry as a row of the ledger export.
+func (e LedgerEntry) CSVRecord() []string {
+	return []string{
+		fmt.Sprintf("%d", e.ID),
+		fmt.Sprintf("%d", e.Height),
+		e.Time.UTC().Format(time.RFC3339),
+		e.Category,
+		e.Reference,
+		e.From,
+		e.To,
+		e.Amount.String(),
+	}
+}
@@ -287,15 +287,18 @@ func (msg MsgCancelDisbursement) GetSigners() []sdk.AccAddress {
 }
 
 // MsgCreateSellOrder
+// The order fails if it would return less than MinReceived, an empty MinReceived accepts any amount.
 type MsgCreateSellOrder struct {
 	Seller          sdk.AccAddress `json:"seller" yaml:"seller"`
 	Amount          sdk.Coins      `json:"amount" yaml:"amount"`
+	MinReceived     sdk.Coins      `json:"min_received" yaml:"min_received"`
 }
 
-func NewMsgCreateSellOrder(seller sdk.AccAddress, amount sdk.Coins) MsgCreateSellOrder {
+func NewMsgCreateSellOrder(seller sdk.AccAddress, amount sdk.Coins, minReceived sdk.Coins) MsgCreateSellOrder {
 	return MsgCreateSellOrder{
 		Seller:          seller,
 		Amount:          amount,
+		MinReceived:     minReceived,
 	}
 }
 
@@ -310,6 +313,9 @@ func (msg MsgCreateSellOrder) ValidateBasic() error {
 	if ! msg.Amount.AmountOf(config.DefaultDenom).IsPositive() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid amount.")
 	}
+	if ! msg.MinReceived.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid minimum received amount.")
+	}
 	return nil
 }
 
@@ -322,15 +328,21 @@ func (msg MsgCreateSellOrder) GetSigners() []sdk.AccAddress {
 }
 
 // MsgCreateBuyOrder
+// The order fails if it would return less than MinReceived or pay more than MaxPrice DIN per PIN on average.
+// An empty MinReceived or a zero MaxPrice disables the respective check.
 type MsgCreateBuyOrder struct {
-	Buyer 	sdk.AccAddress 	`json:"buyer" yaml:"buyer"`
-	Amount 	sdk.Coins 		`json:"amount" yaml:"amount"`
+	Buyer 		sdk.AccAddress 	`json:"buyer" yaml:"buyer"`
+	Amount 		sdk.Coins 		`json:"amount" yaml:"amount"`
+	MinReceived sdk.Coins 		`json:"min_received" yaml:"min_received"`
+	MaxPrice 	sdk.Dec 		`json:"max_price" yaml:"max_price"`
 }
 
-func NewMsgCreateBuyOrder(buyer sdk.AccAddress, amount sdk.Coins) MsgCreateBuyOrder {
+func NewMsgCreateBuyOrder(buyer sdk.AccAddress, amount sdk.Coins, minReceived sdk.Coins, maxPrice sdk.Dec) MsgCreateBuyOrder {
 	return MsgCreateBuyOrder{
 		Buyer: buyer,
 		Amount: amount,
+		MinReceived: minReceived,
+		MaxPrice: maxPrice,
 	}
 }
 
@@ -345,6 +357,12 @@ func (msg MsgCreateBuyOrder) ValidateBasic() error {
 	if ! msg.Amount.AmountOf(config.DefaultStableDenom).IsPositive() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid amount.")
 	}
+	if ! msg.MinReceived.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid minimum received amount.")
+	}
+	if ! msg.MaxPrice.IsNil() && msg.MaxPrice.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid maximum price.")
+	}
 	return nil
 }
 
@@ -356,7 +374,8 @@ func (msg MsgCreateBuyOrder) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Buyer}
 }
 
-// MsgSwap
+// MsgSwap is no longer handled, swapped tokens are released through attested swap claims. It stays registered so
+// that transactions already on chain can still be decoded.
 type MsgSwap struct {
 	Operator  sdk.AccAddress `json:"operator" yaml:"operator"`
 	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
@@ -399,4 +418,438 @@ func (msg MsgSwap) GetSignBytes() []byte {
 
 func (msg MsgSwap) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Operator}
-}
\ No newline at end of file
+}
+
+// MsgDisburseVesting
+type MsgDisburseVesting struct {
+	Operator  sdk.AccAddress `json:"operator" yaml:"operator"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Reference string         `json:"reference" yaml:"reference"`
+	Periods   VestingPeriods `json:"periods" yaml:"periods"`
+}
+
+func NewMsgDisburseVesting(sender sdk.AccAddress, recipient sdk.AccAddress, reference string, periods VestingPeriods) MsgDisburseVesting {
+	return MsgDisburseVesting{
+		Operator:  sender,
+		Recipient: recipient,
+		Reference: reference,
+		Periods:   periods,
+	}
+}
+
+func (msg MsgDisburseVesting) Route() string { return RouterKey }
+
+func (msg MsgDisburseVesting) Type() string { return "disburse_vesting" }
+
+func (msg MsgDisburseVesting) ValidateBasic() error {
+	if msg.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Operator.String())
+	}
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient.String())
+	}
+	if len(msg.Reference) == 0 {
+		return sdkerrors.Wrap(ErrInvalidReference, "Reference is required")
+	}
+	if len(msg.Reference) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "Reference too long")
+	}
+	if err := msg.Periods.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVestingSchedule, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgDisburseVesting) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgDisburseVesting) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
+
+// MsgCancelVestingDisbursement
+type MsgCancelVestingDisbursement struct {
+	Manager   sdk.AccAddress `json:"manager" yaml:"manager"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Reference string         `json:"reference" yaml:"reference"`
+}
+
+func NewMsgCancelVestingDisbursement(manager sdk.AccAddress, recipient sdk.AccAddress, reference string) MsgCancelVestingDisbursement {
+	return MsgCancelVestingDisbursement{
+		Manager:   manager,
+		Recipient: recipient,
+		Reference: reference,
+	}
+}
+
+func (msg MsgCancelVestingDisbursement) Route() string { return RouterKey }
+
+func (msg MsgCancelVestingDisbursement) Type() string { return "cancel_vesting_disbursement" }
+
+func (msg MsgCancelVestingDisbursement) ValidateBasic() error {
+	if msg.Manager.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Manager.String())
+	}
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient.String())
+	}
+	if len(msg.Reference) == 0 {
+		return sdkerrors.Wrap(ErrInvalidReference, "Reference is required")
+	}
+	if len(msg.Reference) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "Reference too long")
+	}
+	return nil
+}
+
+func (msg MsgCancelVestingDisbursement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCancelVestingDisbursement) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Manager}
+}
+
+// MsgClaimVesting
+type MsgClaimVesting struct {
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Reference string         `json:"reference" yaml:"reference"`
+}
+
+func NewMsgClaimVesting(recipient sdk.AccAddress, reference string) MsgClaimVesting {
+	return MsgClaimVesting{
+		Recipient: recipient,
+		Reference: reference,
+	}
+}
+
+func (msg MsgClaimVesting) Route() string { return RouterKey }
+
+func (msg MsgClaimVesting) Type() string { return "claim_vesting" }
+
+func (msg MsgClaimVesting) ValidateBasic() error {
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient.String())
+	}
+	if len(msg.Reference) == 0 {
+		return sdkerrors.Wrap(ErrInvalidReference, "Reference is required")
+	}
+	if len(msg.Reference) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "Reference too long")
+	}
+	return nil
+}
+
+func (msg MsgClaimVesting) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgClaimVesting) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Recipient}
+}
+
+// MsgClaimSwap is signed by the recipient of the swapped tokens, operators attest the claim before it is paid out.
+type MsgClaimSwap struct {
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+	Reference string         `json:"reference" yaml:"reference"`
+}
+
+func NewMsgClaimSwap(recipient sdk.AccAddress, amount sdk.Coins, reference string) MsgClaimSwap {
+	return MsgClaimSwap{
+		Recipient: recipient,
+		Amount:    amount,
+		Reference: reference,
+	}
+}
+
+func (msg MsgClaimSwap) Route() string { return RouterKey }
+
+func (msg MsgClaimSwap) Type() string { return "claim_swap" }
+
+func (msg MsgClaimSwap) ValidateBasic() error {
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient.String())
+	}
+	if ! msg.Amount.AmountOf(config.DefaultDenom).IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid amount.")
+	}
+	if len(msg.Reference) == 0 {
+		return sdkerrors.Wrap(ErrInvalidReference, "Reference is required")
+	}
+	if len(msg.Reference) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "Reference too long")
+	}
+	return nil
+}
+
+func (msg MsgClaimSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgClaimSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Recipient}
+}
+
+// MsgAttestSwap repeats the recipient and amount of the claim, the attestation is only valid if they match.
+type MsgAttestSwap struct {
+	Operator  sdk.AccAddress `json:"operator" yaml:"operator"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+	Reference string         `json:"reference" yaml:"reference"`
+}
+
+func NewMsgAttestSwap(operator sdk.AccAddress, recipient sdk.AccAddress, amount sdk.Coins, reference string) MsgAttestSwap {
+	return MsgAttestSwap{
+		Operator:  operator,
+		Recipient: recipient,
+		Amount:    amount,
+		Reference: reference,
+	}
+}
+
+func (msg MsgAttestSwap) Route() string { return RouterKey }
+
+func (msg MsgAttestSwap) Type() string { return "attest_swap" }
+
+func (msg MsgAttestSwap) ValidateBasic() error {
+	if msg.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Operator.String())
+	}
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient.String())
+	}
+	if ! msg.Amount.AmountOf(config.DefaultDenom).IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid amount.")
+	}
+	if len(msg.Reference) == 0 {
+		return sdkerrors.Wrap(ErrInvalidReference, "Reference is required")
+	}
+	if len(msg.Reference) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "Reference too long")
+	}
+	return nil
+}
+
+func (msg MsgAttestSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgAttestSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
+
+// MsgRejectSwap
+type MsgRejectSwap struct {
+	Operator  sdk.AccAddress `json:"operator" yaml:"operator"`
+	Reference string         `json:"reference" yaml:"reference"`
+}
+
+func NewMsgRejectSwap(operator sdk.AccAddress, reference string) MsgRejectSwap {
+	return MsgRejectSwap{
+		Operator:  operator,
+		Reference: reference,
+	}
+}
+
+func (msg MsgRejectSwap) Route() string { return RouterKey }
+
+func (msg MsgRejectSwap) Type() string { return "reject_swap" }
+
+func (msg MsgRejectSwap) ValidateBasic() error {
+	if msg.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Operator.String())
+	}
+	if len(msg.Reference) == 0 {
+		return sdkerrors.Wrap(ErrInvalidReference, "Reference is required")
+	}
+	if len(msg.Reference) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "Reference too long")
+	}
+	return nil
+}
+
+func (msg MsgRejectSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRejectSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
+
+// MsgApproveDisbursement
+type MsgApproveDisbursement struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	ScheduledFor string `json:"scheduled_for" yaml:"scheduled_for"` // has to be string and parsed on server because of broken amino decoding
+}
+
+func NewMsgApproveDisbursement(sender sdk.AccAddress, recipient sdk.AccAddress, scheduledFor string) MsgApproveDisbursement {
+	return MsgApproveDisbursement{
+		Sender: sender,
+		Recipient: recipient,
+		ScheduledFor: scheduledFor,
+	}
+}
+
+func (msg MsgApproveDisbursement) Route() string { return RouterKey }
+
+func (msg MsgApproveDisbursement) Type() string { return "approve_disbursement" }
+
+func (msg MsgApproveDisbursement) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender.String())
+	}
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient.String())
+	}
+	scheduledFor, err := time.Parse("2006-01-02T15:04:05.99999999999Z", msg.ScheduledFor)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidTime, msg.ScheduledFor)
+	}
+	if scheduledFor.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidTime, msg.ScheduledFor)
+	}
+	return nil
+}
+
+func (msg MsgApproveDisbursement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgApproveDisbursement) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgRejectDisbursement
+type MsgRejectDisbursement struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	ScheduledFor string `json:"scheduled_for" yaml:"scheduled_for"` // has to be string and parsed on server because of broken amino decoding
+}
+
+func NewMsgRejectDisbursement(sender sdk.AccAddress, recipient sdk.AccAddress, scheduledFor string) MsgRejectDisbursement {
+	return MsgRejectDisbursement{
+		Sender: sender,
+		Recipient: recipient,
+		ScheduledFor: scheduledFor,
+	}
+}
+
+func (msg MsgRejectDisbursement) Route() string { return RouterKey }
+
+func (msg MsgRejectDisbursement) Type() string { return "reject_disbursement" }
+
+func (msg MsgRejectDisbursement) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender.String())
+	}
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient.String())
+	}
+	scheduledFor, err := time.Parse("2006-01-02T15:04:05.99999999999Z", msg.ScheduledFor)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidTime, msg.ScheduledFor)
+	}
+	if scheduledFor.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidTime, msg.ScheduledFor)
+	}
+	return nil
+}
+
+func (msg MsgRejectDisbursement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRejectDisbursement) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgRetryDisbursement
+type MsgRetryDisbursement struct {
+	Manager sdk.AccAddress `json:"manager" yaml:"manager"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	ScheduledFor string `json:"scheduled_for" yaml:"scheduled_for"` // has to be string and parsed on server because of broken amino decoding
+}
+
+func NewMsgRetryDisbursement(manager sdk.AccAddress, recipient sdk.AccAddress, scheduledFor string) MsgRetryDisbursement {
+	return MsgRetryDisbursement{
+		Manager: manager,
+		Recipient: recipient,
+		ScheduledFor: scheduledFor,
+	}
+}
+
+func (msg MsgRetryDisbursement) Route() string { return RouterKey }
+
+func (msg MsgRetryDisbursement) Type() string { return "retry_disbursement" }
+
+func (msg MsgRetryDisbursement) ValidateBasic() error {
+	if msg.Manager.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Manager.String())
+	}
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient.String())
+	}
+	scheduledFor, err := time.Parse("2006-01-02T15:04:05.99999999999Z", msg.ScheduledFor)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidTime, msg.ScheduledFor)
+	}
+	if scheduledFor.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidTime, msg.ScheduledFor)
+	}
+	return nil
+}
+
+func (msg MsgRetryDisbursement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRetryDisbursement) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Manager}
+}
+
+// MsgCancelFailedDisbursement
+type MsgCancelFailedDisbursement struct {
+	Manager sdk.AccAddress `json:"manager" yaml:"manager"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	ScheduledFor string `json:"scheduled_for" yaml:"scheduled_for"` // has to be string and parsed on server because of broken amino decoding
+}
+
+func NewMsgCancelFailedDisbursement(manager sdk.AccAddress, recipient sdk.AccAddress, scheduledFor string) MsgCancelFailedDisbursement {
+	return MsgCancelFailedDisbursement{
+		Manager: manager,
+		Recipient: recipient,
+		ScheduledFor: scheduledFor,
+	}
+}
+
+func (msg MsgCancelFailedDisbursement) Route() string { return RouterKey }
+
+func (msg MsgCancelFailedDisbursement) Type() string { return "cancel_failed_disbursement" }
+
+func (msg MsgCancelFailedDisbursement) ValidateBasic() error {
+	if msg.Manager.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Manager.String())
+	}
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient.String())
+	}
+	scheduledFor, err := time.Parse("2006-01-02T15:04:05.99999999999Z", msg.ScheduledFor)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidTime, msg.ScheduledFor)
+	}
+	if scheduledFor.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidTime, msg.ScheduledFor)
+	}
+	return nil
+}
+
+func (msg MsgCancelFailedDisbursement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCancelFailedDisbursement) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Manager}
+}
@@ -13,6 +13,19 @@ const (
 
 	DefaultRiskAssesmentDuration 	= time.Hour * 24 * 3 // devnet: time.Second * 60 * 2
 	DefaultRiskAssesmentAmount		= 10000 // usd
+
+	DefaultDisbursementApprovalThreshold uint64 = 1
+	DefaultDisbursementRejectionThreshold uint64 = 1
+
+	DefaultDisbursementLimitWindow		= time.Hour * 24
+	DefaultOperatorDisbursementLimit	= 50000 // usd
+	DefaultTreasuryDisbursementLimit	= 200000 // usd
+
+	DefaultMaxLimitOrderDuration		= time.Hour * 24 * 30
+
+	DefaultSwapAttestationThreshold uint64 = 2
+
+	DefaultLedgerRetention uint64 = 3153600 // blocks, about half a year of 5 second blocks
 )
 
 var (
@@ -20,6 +33,15 @@ var (
 	KeyRiskAssessmentAmount   = []byte("RiskAssesmentAmount")
 	KeyRiskAssessmentDuration = []byte("RiskAssesmentDuration")
 	KeyBuyBackPercentage      = []byte("BuyBackPercentage")
+	KeyDisbursementApprovalThreshold = []byte("DisbursementApprovalThreshold")
+	KeyDisbursementRejectionThreshold = []byte("DisbursementRejectionThreshold")
+	KeyDisbursementLimitWindow       = []byte("DisbursementLimitWindow")
+	KeyOperatorDisbursementLimit     = []byte("OperatorDisbursementLimit")
+	KeyTreasuryDisbursementLimit     = []byte("TreasuryDisbursementLimit")
+	KeyBondingCurve                  = []byte("BondingCurve")
+	KeyMaxLimitOrderDuration         = []byte("MaxLimitOrderDuration")
+	KeySwapAttestationThreshold      = []byte("SwapAttestationThreshold")
+	KeyLedgerRetention               = []byte("LedgerRetention")
 
 	DefaultManagerAddress = "anatha1qaf2gssp652s6np00a5cxdwytdf3vutdumwc0q"
 
@@ -35,14 +57,32 @@ type Params struct {
 	RiskAssessmentAmount   sdk.Coins      `json:"risk_assesment_amount" yaml:"risk_assesment_amount"`
 	RiskAssessmentDuration time.Duration  `json:"risk_assesment_duration" yaml:"risk_assesment_duration"`
 	BuyBackPercentage      sdk.Dec        `json:"buyback_percentage" yaml:"buyback_percentage"`
+	DisbursementApprovalThreshold uint64  `json:"disbursement_approval_threshold" yaml:"disbursement_approval_threshold"` // distinct approvals needed for disbursements of at least RiskAssessmentAmount
+	DisbursementRejectionThreshold uint64 `json:"disbursement_rejection_threshold" yaml:"disbursement_rejection_threshold"` // distinct rejections that remove a disbursement waiting for approvals
+	DisbursementLimitWindow   time.Duration `json:"disbursement_limit_window" yaml:"disbursement_limit_window"`
+	OperatorDisbursementLimit sdk.Coins     `json:"operator_disbursement_limit" yaml:"operator_disbursement_limit"` // empty means no limit
+	TreasuryDisbursementLimit sdk.Coins     `json:"treasury_disbursement_limit" yaml:"treasury_disbursement_limit"` // empty means no limit
+	BondingCurve              BondingCurve  `json:"bonding_curve" yaml:"bonding_curve"`
+	MaxLimitOrderDuration     time.Duration `json:"max_limit_order_duration" yaml:"max_limit_order_duration"`
+	SwapAttestationThreshold  uint64        `json:"swap_attestation_threshold" yaml:"swap_attestation_threshold"` // distinct operator attestations needed to release a swap claim
+	LedgerRetention           uint64        `json:"ledger_retention" yaml:"ledger_retention"` // blocks the ledger entries are kept for
 }
 
-func NewParams(managers []sdk.AccAddress, amount sdk.Coins, riskAssessmentDuration time.Duration, buybackPercentage sdk.Dec) Params {
+func NewParams(managers []sdk.AccAddress, amount sdk.Coins, riskAssessmentDuration time.Duration, buybackPercentage sdk.Dec, disbursementApprovalThreshold uint64, disbursementRejectionThreshold uint64, disbursementLimitWindow time.Duration, operatorDisbursementLimit sdk.Coins, treasuryDisbursementLimit sdk.Coins, bondingCurve BondingCurve, maxLimitOrderDuration time.Duration, swapAttestationThreshold uint64, ledgerRetention uint64) Params {
 	return Params{
 		Managers:               managers,
 		RiskAssessmentAmount:   amount,
 		RiskAssessmentDuration: riskAssessmentDuration,
 		BuyBackPercentage:      buybackPercentage,
+		DisbursementApprovalThreshold: disbursementApprovalThreshold,
+		DisbursementRejectionThreshold: disbursementRejectionThreshold,
+		DisbursementLimitWindow:   disbursementLimitWindow,
+		OperatorDisbursementLimit: operatorDisbursementLimit,
+		TreasuryDisbursementLimit: treasuryDisbursementLimit,
+		BondingCurve:              bondingCurve,
+		MaxLimitOrderDuration:     maxLimitOrderDuration,
+		SwapAttestationThreshold:  swapAttestationThreshold,
+		LedgerRetention:           ledgerRetention,
 	}
 }
 
@@ -51,7 +91,16 @@ func (p Params) String() string {
 	Managers: %s
 	RiskAssesmentAmount: %s
 	RiskAssesmentDuration: %s
-	`, p.Managers, p.RiskAssessmentAmount, p.RiskAssessmentDuration)
+	DisbursementApprovalThreshold: %d
+	DisbursementRejectionThreshold: %d
+	DisbursementLimitWindow: %s
+	OperatorDisbursementLimit: %s
+	TreasuryDisbursementLimit: %s
+	BondingCurve: %s
+	MaxLimitOrderDuration: %s
+	SwapAttestationThreshold: %d
+	LedgerRetention: %d
+	`, p.Managers, p.RiskAssessmentAmount, p.RiskAssessmentDuration, p.DisbursementApprovalThreshold, p.DisbursementRejectionThreshold, p.DisbursementLimitWindow, p.OperatorDisbursementLimit, p.TreasuryDisbursementLimit, p.BondingCurve, p.MaxLimitOrderDuration, p.SwapAttestationThreshold, p.LedgerRetention)
 }
 
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
@@ -60,18 +109,38 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyRiskAssessmentAmount, &p.RiskAssessmentAmount, validateCoins),
 		params.NewParamSetPair(KeyRiskAssessmentDuration, &p.RiskAssessmentDuration, validateDuration),
 		params.NewParamSetPair(KeyBuyBackPercentage, &p.BuyBackPercentage, validateBuyBackPercentage),
+		params.NewParamSetPair(KeyDisbursementApprovalThreshold, &p.DisbursementApprovalThreshold, validateApprovalThreshold),
+		params.NewParamSetPair(KeyDisbursementRejectionThreshold, &p.DisbursementRejectionThreshold, validateRejectionThreshold),
+		params.NewParamSetPair(KeyDisbursementLimitWindow, &p.DisbursementLimitWindow, validateDuration),
+		params.NewParamSetPair(KeyOperatorDisbursementLimit, &p.OperatorDisbursementLimit, validateCoins),
+		params.NewParamSetPair(KeyTreasuryDisbursementLimit, &p.TreasuryDisbursementLimit, validateCoins),
+		params.NewParamSetPair(KeyBondingCurve, &p.BondingCurve, validateBondingCurve),
+		params.NewParamSetPair(KeyMaxLimitOrderDuration, &p.MaxLimitOrderDuration, validateDuration),
+		params.NewParamSetPair(KeySwapAttestationThreshold, &p.SwapAttestationThreshold, validateAttestationThreshold),
+		params.NewParamSetPair(KeyLedgerRetention, &p.LedgerRetention, validateLedgerRetention),
 	}
 }
 
 func DefaultParams() Params {
 	manager, _ := sdk.AccAddressFromBech32(DefaultManagerAddress)
 	amount, _ := sdk.ConvertCoin(sdk.NewInt64Coin("usd", DefaultRiskAssesmentAmount), "din")
+	operatorLimit, _ := sdk.ConvertCoin(sdk.NewInt64Coin("usd", DefaultOperatorDisbursementLimit), "din")
+	treasuryLimit, _ := sdk.ConvertCoin(sdk.NewInt64Coin("usd", DefaultTreasuryDisbursementLimit), "din")
 
 	return NewParams(
 		[]sdk.AccAddress{manager},
 		sdk.NewCoins(amount),
 		DefaultRiskAssesmentDuration,
 		DefaultBuyBackPercentage,
+		DefaultDisbursementApprovalThreshold,
+		DefaultDisbursementRejectionThreshold,
+		DefaultDisbursementLimitWindow,
+		sdk.NewCoins(operatorLimit),
+		sdk.NewCoins(treasuryLimit),
+		DefaultBondingCurve(),
+		DefaultMaxLimitOrderDuration,
+		DefaultSwapAttestationThreshold,
+		DefaultLedgerRetention,
 	)
 }
 
@@ -92,9 +161,69 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateApprovalThreshold(p.DisbursementApprovalThreshold); err != nil {
+		return err
+	}
+
+	if err := validateRejectionThreshold(p.DisbursementRejectionThreshold); err != nil {
+		return err
+	}
+
+	if err := validateDuration(p.DisbursementLimitWindow); err != nil {
+		return err
+	}
+
+	if err := validateCoins(p.OperatorDisbursementLimit); err != nil {
+		return err
+	}
+
+	if err := validateCoins(p.TreasuryDisbursementLimit); err != nil {
+		return err
+	}
+
+	if err := validateBondingCurve(p.BondingCurve); err != nil {
+		return err
+	}
+
+	if err := validateDuration(p.MaxLimitOrderDuration); err != nil {
+		return err
+	}
+
+	if err := validateAttestationThreshold(p.SwapAttestationThreshold); err != nil {
+		return err
+	}
+
+	if err := validateLedgerRetention(p.LedgerRetention); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateDisbursementThresholds checks that the given number of managers and operators can reach the disbursement
+// approval and rejection thresholds.
+func (p Params) ValidateDisbursementThresholds(voters uint64) error {
+	if p.DisbursementApprovalThreshold > voters {
+		return fmt.Errorf("disbursement approval threshold %d is higher than the %d managers and operators", p.DisbursementApprovalThreshold, voters)
+	}
+	if p.DisbursementRejectionThreshold > voters {
+		return fmt.Errorf("disbursement rejection threshold %d is higher than the %d managers and operators", p.DisbursementRejectionThreshold, voters)
+	}
+
 	return nil
 }
 
+// CountDisbursementVoters returns the number of distinct addresses that can approve or reject disbursements.
+func CountDisbursementVoters(managers []sdk.AccAddress, operators []sdk.AccAddress) uint64 {
+	voters := make(map[string]bool)
+
+	for _, address := range append(append([]sdk.AccAddress{}, managers...), operators...) {
+		voters[address.String()] = true
+	}
+
+	return uint64(len(voters))
+}
+
 func validateManager(i interface{}) error {
 	addresses, ok := i.([]sdk.AccAddress)
 	if !ok {
@@ -139,11 +268,58 @@ func validateBuyBackPercentage(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 	if v.GT(sdk.OneDec()) {
-		return fmt.Errorf("buyback percentage must be less than 100%: %s", v)
+		return fmt.Errorf("buyback percentage must be less than 100%%: %s", v)
 	}
 	if v.IsNegative() {
 		return fmt.Errorf("buyback percentage must be positive: %s", v)
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+func validateApprovalThreshold(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("disbursement approval threshold must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRejectionThreshold(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("disbursement rejection threshold must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateAttestationThreshold(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("swap attestation threshold must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateLedgerRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("ledger retention must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -15,6 +15,7 @@ const (
 	ProposalTypeTransferFromDistributionProfitsToBuyBackLiquidity = "TransferFromDistributionProfitsToBuyBackLiquidity"
 	ProposalTypeTransferFromTreasuryToSwapEscrow = "TransferFromTreasuryToSwapEscrow"
 	ProposalTypeTransferSwapEscrowToBuyBack = "TransferSwapEscrowToBuyBack"
+	ProposalTypeSetBondingCurve = "SetBondingCurve"
 )
 
 func init() {
@@ -30,6 +31,8 @@ func init() {
 	gov.RegisterProposalTypeCodec(TransferFromTreasuryToSwapEscrowProposal{}, "treasury/TransferFromTreasuryToSwapEscrowProposal")
 	gov.RegisterProposalType(ProposalTypeTransferSwapEscrowToBuyBack)
 	gov.RegisterProposalTypeCodec(TransferFromSwapEscrowToBuyBackProposal{}, "treasury/TransferFromSwapEscrowToBuyBackProposal")
+	gov.RegisterProposalType(ProposalTypeSetBondingCurve)
+	gov.RegisterProposalTypeCodec(SetBondingCurveProposal{}, "treasury/SetBondingCurveProposal")
 }
 
 var _ gov.Content = AddBuyBackLiquidityProposal{}
@@ -222,4 +225,43 @@ func (p TransferFromSwapEscrowToBuyBackProposal) String() string {
   Description: 	%s
   Amount: 		%s
 `, p.Title, p.Description, p.Amount)
-}
\ No newline at end of file
+}
+
+var _ gov.Content = SetBondingCurveProposal{}
+
+// SetBondingCurveProposal replaces the curve the treasury sells PIN on. CoinsPerStage is left unchanged when empty.
+type SetBondingCurveProposal struct {
+	Title       	string `json:"title" yaml:"title"`
+	Description 	string `json:"description" yaml:"description"`
+	BondingCurve 	BondingCurve `json:"bonding_curve" yaml:"bonding_curve"`
+	CoinsPerStage 	sdk.Coins `json:"coins_per_stage" yaml:"coins_per_stage"`
+}
+
+func NewSetBondingCurveProposal(title, description string, bondingCurve BondingCurve, coinsPerStage sdk.Coins) gov.Content {
+	return SetBondingCurveProposal{title, description, bondingCurve, coinsPerStage}
+}
+
+func (p SetBondingCurveProposal) GetTitle() string       { return p.Title }
+func (p SetBondingCurveProposal) GetDescription() string { return p.Description }
+func (p SetBondingCurveProposal) ProposalRoute() string  { return RouterKey }
+func (p SetBondingCurveProposal) ProposalType() string   { return ProposalTypeSetBondingCurve }
+func (p SetBondingCurveProposal) ValidateBasic() error {
+	if err := p.BondingCurve.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidBondingCurve, err.Error())
+	}
+
+	if ! p.CoinsPerStage.IsValid() || (! p.CoinsPerStage.Empty() && p.CoinsPerStage.AmountOf(config.DefaultDenom).IsZero()) {
+		return sdkerrors.ErrInvalidCoins
+	}
+
+	return gov.ValidateAbstract(p)
+}
+
+func (p SetBondingCurveProposal) String() string {
+	return fmt.Sprintf(`Set Bonding Curve Proposal:
+  Title: 			%s
+  Description: 		%s
+  BondingCurve: 	%s
+  CoinsPerStage: 	%s
+`, p.Title, p.Description, p.BondingCurve, p.CoinsPerStage)
+}
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"strings"
+	"time"
 )
 
 type QueryResOperators []sdk.AccAddress
@@ -29,8 +31,146 @@ func (n QueryResDisbursements) String() string {
 	return strings.Join(disbursements, "\n")
 }
 
+type QueryResFailedDisbursements []FailedDisbursement
+
+func (n QueryResFailedDisbursements) String() string {
+	var disbursements []string
+
+	for _, disbursement := range n {
+		disbursements = append(disbursements, disbursement.String())
+	}
+
+	return strings.Join(disbursements, "\n")
+}
+
 type QueryResPrice sdk.Coins
 
 func (n QueryResPrice) String() string {
 	return n.String()
-}
\ No newline at end of file
+}
+
+type QueryResDisbursementUsage struct {
+	Window 			time.Duration 	`json:"window" yaml:"window"`
+	TreasuryUsage 	sdk.Coins 		`json:"treasury_usage" yaml:"treasury_usage"`
+	TreasuryLimit 	sdk.Coins 		`json:"treasury_limit" yaml:"treasury_limit"`
+	Operator 		sdk.AccAddress 	`json:"operator,omitempty" yaml:"operator"`
+	OperatorUsage 	sdk.Coins 		`json:"operator_usage" yaml:"operator_usage"`
+	OperatorLimit 	sdk.Coins 		`json:"operator_limit" yaml:"operator_limit"`
+}
+
+func (r QueryResDisbursementUsage) String() string {
+	return fmt.Sprintf(`Window: %s
+TreasuryUsage: %s
+TreasuryLimit: %s
+Operator: %s
+OperatorUsage: %s
+OperatorLimit: %s`, r.Window, r.TreasuryUsage, r.TreasuryLimit, r.Operator, r.OperatorUsage, r.OperatorLimit)
+}
+
+const (
+	QuoteSideBuy 	= "buy"
+	QuoteSideSell 	= "sell"
+)
+
+// QueryResQuote is the exact outcome of a buy or sell order executed in the current state. Buy orders are filled from
+// the buyback fund first and from the treasury for the rest, sell orders are always paid by the buyback fund.
+type QueryResQuote struct {
+	Side 			string 		`json:"side" yaml:"side"`
+	Amount 			sdk.Coins 	`json:"amount" yaml:"amount"`
+	Received 		sdk.Coins 	`json:"received" yaml:"received"`
+	FromBuyBack 	sdk.Coins 	`json:"from_buyback" yaml:"from_buyback"`
+	FromTreasury 	sdk.Coins 	`json:"from_treasury" yaml:"from_treasury"`
+	AveragePrice 	sdk.Dec 	`json:"average_price" yaml:"average_price"`
+}
+
+func (r QueryResQuote) String() string {
+	return fmt.Sprintf(`Side: %s
+Amount: %s
+Received: %s
+FromBuyBack: %s
+FromTreasury: %s
+AveragePrice: %s`, r.Side, r.Amount, r.Received, r.FromBuyBack, r.FromTreasury, r.AveragePrice)
+}
+
+// QueryLedgerMaxLimit bounds the ledger entries returned by a single query, larger exports are paged.
+const QueryLedgerMaxLimit = 1000
+
+type QueryLedgerParams struct {
+	FromHeight 	int64  `json:"from_height" yaml:"from_height"`
+	FromID 		uint64 `json:"from_id" yaml:"from_id"`
+	ToHeight 	int64  `json:"to_height" yaml:"to_height"`
+	Limit 		int    `json:"limit" yaml:"limit"`
+}
+
+// NewQueryLedgerParams selects a page of the ledger entries recorded up to ToHeight, inclusive, starting with the entry
+// with the given height and id. A zero ToHeight selects everything up to the latest entry. A page holds at most
+// QueryLedgerMaxLimit entries, the next page starts at the height of the last entry with the id that follows it.
+func NewQueryLedgerParams(fromHeight int64, fromID uint64, toHeight int64, limit int) QueryLedgerParams {
+	return QueryLedgerParams{
+		FromHeight: fromHeight,
+		FromID: fromID,
+		ToHeight: toHeight,
+		Limit: limit,
+	}
+}
+
+type QueryResLedger []LedgerEntry
+
+func (n QueryResLedger) String() string {
+	var entries []string
+
+	for _, entry := range n {
+		entries = append(entries, entry.String())
+	}
+
+	return strings.Join(entries, "\n")
+}
+
+type QueryResSwapClaims []SwapClaim
+
+func (n QueryResSwapClaims) String() string {
+	var claims []string
+
+	for _, claim := range n {
+		claims = append(claims, claim.String())
+	}
+
+	return strings.Join(claims, "\n")
+}
+
+// QueryResVestingDisbursement shows a vesting disbursement with its amounts at the current block time.
+type QueryResVestingDisbursement struct {
+	Disbursement 	VestingDisbursement `json:"disbursement" yaml:"disbursement"`
+	Vested 			sdk.Coins 			`json:"vested" yaml:"vested"`
+	Unvested 		sdk.Coins 			`json:"unvested" yaml:"unvested"`
+	Cancelled 		sdk.Coins 			`json:"cancelled" yaml:"cancelled"`
+}
+
+func NewQueryResVestingDisbursement(disbursement VestingDisbursement, now time.Time) QueryResVestingDisbursement {
+	return QueryResVestingDisbursement{
+		Disbursement: disbursement,
+		Vested: disbursement.VestedAmount(now),
+		Unvested: disbursement.UnvestedAmount(now),
+		Cancelled: disbursement.CancelledAmount(),
+	}
+}
+
+func (n QueryResVestingDisbursement) String() string {
+	return fmt.Sprintf(`%s
+	Vested: %s
+	Unvested: %s
+	Cancelled: %s
+	`, n.Disbursement, n.Vested, n.Unvested, n.Cancelled)
+}
+
+type QueryResVestingDisbursements []QueryResVestingDisbursement
+
+func (n QueryResVestingDisbursements) String() string {
+	var disbursements []string
+
+	for _, disbursement := range n {
+		disbursements = append(disbursements, disbursement.String())
+	}
+
+	return strings.Join(disbursements, "\n")
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SwapClaim is a request to release swapped tokens of the legacy chain from the swap escrow. It is opened by its
+// recipient and the reference binds it to the recipient and amount, operators can only attest or reject it as
+// submitted.
+type SwapClaim struct {
+	Reference 				string 				`json:"reference" yaml:"reference"`
+	Recipient 				sdk.AccAddress 		`json:"recipient" yaml:"recipient"`
+	Amount 					sdk.Coins 			`json:"amount" yaml:"amount"`
+	ClaimedAt 				time.Time 			`json:"claimed_at" yaml:"claimed_at"`
+	RequiredAttestations 	uint64 				`json:"required_attestations" yaml:"required_attestations"`
+	Attestations 			[]sdk.AccAddress 	`json:"attestations" yaml:"attestations"`
+	Rejections 				[]sdk.AccAddress 	`json:"rejections" yaml:"rejections"`
+}
+
+func NewSwapClaim(reference string, recipient sdk.AccAddress, amount sdk.Coins, claimedAt time.Time, requiredAttestations uint64) SwapClaim {
+	return SwapClaim{
+		Reference: reference,
+		Recipient: recipient,
+		Amount: amount,
+		ClaimedAt: claimedAt,
+		RequiredAttestations: requiredAttestations,
+		Attestations: []sdk.AccAddress{},
+		Rejections: []sdk.AccAddress{},
+	}
+}
+
+// Matches returns true if the recipient and amount are the ones the claim was submitted with.
+func (c SwapClaim) Matches(recipient sdk.AccAddress, amount sdk.Coins) bool {
+	return c.Recipient.Equals(recipient) && c.Amount.IsEqual(amount)
+}
+
+// IsAttested returns true once enough distinct operators attested the claim.
+func (c SwapClaim) IsAttested() bool {
+	return uint64(len(c.Attestations)) >= c.RequiredAttestations
+}
+
+// IsRejected returns true once as many operators rejected the claim as are needed to attest it.
+func (c SwapClaim) IsRejected() bool {
+	return len(c.Rejections) > 0 && uint64(len(c.Rejections)) >= c.RequiredAttestations
+}
+
+// HasVoted returns true if the operator already attested or rejected the claim.
+func (c SwapClaim) HasVoted(operator sdk.AccAddress) bool {
+	for _, attester := range c.Attestations {
+		if attester.Equals(operator) {
+			return true
+		}
+	}
+
+	for _, rejecter := range c.Rejections {
+		if rejecter.Equals(operator) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c SwapClaim) String() string {
+	return fmt.Sprintf(`
+	Reference: %s
+	Recipient: %s
+	Amount: %s
+	ClaimedAt: %s
+	RequiredAttestations: %d
+	Attestations: %s
+	Rejections: %s
+	`, c.Reference, c.Recipient, c.Amount, c.ClaimedAt, c.RequiredAttestations, c.Attestations, c.Rejections)
+}
@@ -12,18 +12,62 @@ type Disbursement struct {
 	Amount 			sdk.Coins 		`json:"amount" yaml:"amount"`
 	ScheduledFor 	time.Time 		`json:"scheduled_for" yaml:"scheduled_for"`
 	Reference 		string 			`json:"reference" yaml:"reference"`
+	RequiredApprovals 	uint64 				`json:"required_approvals" yaml:"required_approvals"`
+	RequiredRejections 	uint64 				`json:"required_rejections" yaml:"required_rejections"`
+	Approvals 			[]sdk.AccAddress 	`json:"approvals" yaml:"approvals"`
+	Rejections 			[]sdk.AccAddress 	`json:"rejections" yaml:"rejections"`
+	UsageRecordedAt 	time.Time 			`json:"usage_recorded_at" yaml:"usage_recorded_at"` // zero unless the disbursement counts towards the rate limits
 }
 
-func NewDisbursement(operator sdk.AccAddress, recipient sdk.AccAddress, amount sdk.Coins, scheduledFor time.Time, reference string) Disbursement {
+// NewDisbursement creates a disbursement that counts the scheduling operator as its first approval.
+func NewDisbursement(operator sdk.AccAddress, recipient sdk.AccAddress, amount sdk.Coins, scheduledFor time.Time, reference string, requiredApprovals uint64, requiredRejections uint64) Disbursement {
 	return Disbursement{
 		Operator: operator,
 		Recipient: recipient,
 		Amount: amount,
 		ScheduledFor: scheduledFor,
 		Reference: reference,
+		RequiredApprovals: requiredApprovals,
+		RequiredRejections: requiredRejections,
+		Approvals: []sdk.AccAddress{operator},
+		Rejections: []sdk.AccAddress{},
 	}
 }
 
+// IsApproved returns true once enough distinct managers or operators approved the disbursement.
+func (d Disbursement) IsApproved() bool {
+	return uint64(len(d.Approvals)) >= d.RequiredApprovals
+}
+
+// IsRejected returns true once enough distinct managers or operators rejected the disbursement. The rejection quorum is
+// independent of the approval quorum so a single rejection does not block a disbursement that needs few approvals.
+func (d Disbursement) IsRejected() bool {
+	return len(d.Rejections) > 0 && uint64(len(d.Rejections)) >= d.RequiredRejections
+}
+
+// CountsTowardsLimits returns true if the disbursement skipped the risk assessment delay and its amount was recorded as
+// rate limit usage.
+func (d Disbursement) CountsTowardsLimits() bool {
+	return ! d.UsageRecordedAt.IsZero()
+}
+
+// HasVoted returns true if the address already approved or rejected the disbursement.
+func (d Disbursement) HasVoted(address sdk.AccAddress) bool {
+	for _, approver := range d.Approvals {
+		if approver.Equals(address) {
+			return true
+		}
+	}
+
+	for _, rejecter := range d.Rejections {
+		if rejecter.Equals(address) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (d Disbursement) String() string {
 	return fmt.Sprintf(`
 	Operator: %s
@@ -31,7 +75,12 @@ func (d Disbursement) String() string {
 	Amount: %s
 	ScheduledFor: %s
 	Reference: %s
-	`, d.Operator, d.Recipient, d.Amount, d.ScheduledFor, d.Reference)
+	RequiredApprovals: %d
+	RequiredRejections: %d
+	Approvals: %s
+	Rejections: %s
+	UsageRecordedAt: %s
+	`, d.Operator, d.Recipient, d.Amount, d.ScheduledFor, d.Reference, d.RequiredApprovals, d.RequiredRejections, d.Approvals, d.Rejections, d.UsageRecordedAt)
 }
 
 type ReferenceAmountInfo struct {
@@ -46,4 +95,50 @@ func NewReferenceAmountInfo(reference string, amount sdk.Int) ReferenceAmountInf
 func (a ReferenceAmountInfo) String() string {
 	return fmt.Sprintf(`Reference: %s
 Amount: %s`, a.Reference, a.Amount)
-}
\ No newline at end of file
+}
+
+// DisbursementUsage is the amount an operator disbursed without a risk assessment delay at a point in time. Usage
+// records inside the rate limit window count towards the operator and treasury disbursement limits.
+type DisbursementUsage struct {
+	Operator 		sdk.AccAddress 	`json:"operator" yaml:"operator"`
+	Amount 			sdk.Coins 		`json:"amount" yaml:"amount"`
+	DisbursedAt 	time.Time 		`json:"disbursed_at" yaml:"disbursed_at"`
+}
+
+func NewDisbursementUsage(operator sdk.AccAddress, amount sdk.Coins, disbursedAt time.Time) DisbursementUsage {
+	return DisbursementUsage{
+		Operator: operator,
+		Amount: amount,
+		DisbursedAt: disbursedAt,
+	}
+}
+
+func (u DisbursementUsage) String() string {
+	return fmt.Sprintf(`Operator: %s
+Amount: %s
+DisbursedAt: %s`, u.Operator, u.Amount, u.DisbursedAt)
+}
+
+
+// FailedDisbursement is a scheduled disbursement that could not be executed. It is kept until a manager retries or
+// cancels it.
+type FailedDisbursement struct {
+	Disbursement 	Disbursement 	`json:"disbursement" yaml:"disbursement"`
+	Reason 			string 			`json:"reason" yaml:"reason"`
+	FailedAt 		time.Time 		`json:"failed_at" yaml:"failed_at"`
+}
+
+func NewFailedDisbursement(disbursement Disbursement, reason string, failedAt time.Time) FailedDisbursement {
+	return FailedDisbursement{
+		Disbursement: disbursement,
+		Reason: reason,
+		FailedAt: failedAt,
+	}
+}
+
+func (f FailedDisbursement) String() string {
+	return fmt.Sprintf(`%s
+	Reason: %s
+	FailedAt: %s
+	`, f.Disbursement, f.Reason, f.FailedAt)
+}
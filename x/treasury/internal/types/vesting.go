@@ -0,0 +1,222 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/anathatech/project-anatha/config"
+)
+
+const (
+	// MaxVestingPeriods bounds the number of disbursements a vesting schedule adds to the disbursement queue.
+	MaxVestingPeriods = 120
+)
+
+// VestingPeriod releases Amount once Length passed since the end of the previous period, or since the start of the
+// schedule for the first one.
+type VestingPeriod struct {
+	Length 	time.Duration 	`json:"length" yaml:"length"`
+	Amount 	sdk.Coins 		`json:"amount" yaml:"amount"`
+}
+
+func NewVestingPeriod(length time.Duration, amount sdk.Coins) VestingPeriod {
+	return VestingPeriod{
+		Length: length,
+		Amount: amount,
+	}
+}
+
+func (p VestingPeriod) String() string {
+	return fmt.Sprintf(`Length: %s, Amount: %s`, p.Length, p.Amount)
+}
+
+type VestingPeriods []VestingPeriod
+
+// NewLinearVestingPeriods splits amount into equal periods over duration. Everything vesting before the cliff is
+// released at the cliff, rounding remainders are released with the next period.
+func NewLinearVestingPeriods(amount sdk.Coins, cliff time.Duration, duration time.Duration, periods int64) VestingPeriods {
+	var result VestingPeriods
+
+	total := amount.AmountOf(config.DefaultStableDenom)
+	vested := sdk.ZeroInt()
+	previous := time.Duration(0)
+
+	for i := int64(1); i <= periods; i++ {
+		end := time.Duration(int64(duration) / periods * i)
+		if i == periods {
+			end = duration
+		}
+
+		if end < cliff {
+			end = cliff
+		}
+
+		due := total.MulRaw(i).QuoRaw(periods)
+		released := sdk.NewCoins(sdk.NewCoin(config.DefaultStableDenom, due.Sub(vested)))
+		vested = due
+
+		if released.IsZero() {
+			continue
+		}
+
+		if len(result) > 0 && end == previous {
+			result[len(result) - 1].Amount = result[len(result) - 1].Amount.Add(released...)
+			continue
+		}
+
+		result = append(result, NewVestingPeriod(end - previous, released))
+		previous = end
+	}
+
+	return result
+}
+
+// TotalAmount returns the amount released over all periods.
+func (p VestingPeriods) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+
+	for _, period := range p {
+		total = total.Add(period.Amount...)
+	}
+
+	return total
+}
+
+func (p VestingPeriods) Validate() error {
+	if len(p) == 0 || len(p) > MaxVestingPeriods {
+		return fmt.Errorf("vesting schedule needs between 1 and %d periods: %d", MaxVestingPeriods, len(p))
+	}
+
+	for _, period := range p {
+		if period.Length <= 0 {
+			return fmt.Errorf("vesting period length must be positive: %s", period.Length)
+		}
+
+		if ! period.Amount.IsValid() || ! period.Amount.AmountOf(config.DefaultStableDenom).IsPositive() {
+			return fmt.Errorf("vesting period amount must be positive: %s", period.Amount)
+		}
+	}
+
+	return nil
+}
+
+func (p VestingPeriods) String() string {
+	periods := make([]string, len(p))
+	for i, period := range p {
+		periods[i] = period.String()
+	}
+
+	return strings.Join(periods, "\n    ")
+}
+
+// VestingTranche is a vesting period scheduled in the disbursement queue. A tranche cancelled, rejected or expired on its
+// own is marked as dropped.
+type VestingTranche struct {
+	ScheduledFor 	time.Time 	`json:"scheduled_for" yaml:"scheduled_for"`
+	Amount 			sdk.Coins 	`json:"amount" yaml:"amount"`
+	Dropped 		bool 		`json:"dropped" yaml:"dropped"`
+}
+
+func NewVestingTranche(scheduledFor time.Time, amount sdk.Coins) VestingTranche {
+	return VestingTranche{
+		ScheduledFor: scheduledFor,
+		Amount: amount,
+	}
+}
+
+func (t VestingTranche) String() string {
+	return fmt.Sprintf(`ScheduledFor: %s, Amount: %s, Dropped: %t`, t.ScheduledFor, t.Amount, t.Dropped)
+}
+
+// VestingDisbursement tracks a disbursement which is released in tranches through the disbursement queue. A cancelled
+// disbursement keeps the tranches scheduled up to CancelledAt.
+type VestingDisbursement struct {
+	Operator 		sdk.AccAddress 		`json:"operator" yaml:"operator"`
+	Recipient 		sdk.AccAddress 		`json:"recipient" yaml:"recipient"`
+	Reference 		string 				`json:"reference" yaml:"reference"`
+	Amount 			sdk.Coins 			`json:"amount" yaml:"amount"`
+	Tranches 		[]VestingTranche 	`json:"tranches" yaml:"tranches"`
+	CancelledAt 	time.Time 			`json:"cancelled_at" yaml:"cancelled_at"`
+}
+
+func NewVestingDisbursement(operator sdk.AccAddress, recipient sdk.AccAddress, reference string, tranches []VestingTranche) VestingDisbursement {
+	amount := sdk.NewCoins()
+	for _, tranche := range tranches {
+		amount = amount.Add(tranche.Amount...)
+	}
+
+	return VestingDisbursement{
+		Operator: operator,
+		Recipient: recipient,
+		Reference: reference,
+		Amount: amount,
+		Tranches: tranches,
+	}
+}
+
+func (v VestingDisbursement) IsCancelled() bool {
+	return ! v.CancelledAt.IsZero()
+}
+
+// isForfeited returns true if the tranche was dropped on its own or by the cancellation of the disbursement.
+func (v VestingDisbursement) isForfeited(tranche VestingTranche) bool {
+	return tranche.Dropped || (v.IsCancelled() && tranche.ScheduledFor.After(v.CancelledAt))
+}
+
+// VestedAmount returns the amount of the tranches scheduled up to the given time.
+func (v VestingDisbursement) VestedAmount(now time.Time) sdk.Coins {
+	vested := sdk.NewCoins()
+
+	for _, tranche := range v.Tranches {
+		if ! tranche.ScheduledFor.After(now) && ! v.isForfeited(tranche) {
+			vested = vested.Add(tranche.Amount...)
+		}
+	}
+
+	return vested
+}
+
+// UnvestedAmount returns the amount of the tranches which are still to vest after the given time.
+func (v VestingDisbursement) UnvestedAmount(now time.Time) sdk.Coins {
+	unvested := sdk.NewCoins()
+
+	for _, tranche := range v.Tranches {
+		if tranche.ScheduledFor.After(now) && ! v.isForfeited(tranche) {
+			unvested = unvested.Add(tranche.Amount...)
+		}
+	}
+
+	return unvested
+}
+
+// CancelledAmount returns the amount of the tranches dropped on their own or by the cancellation.
+func (v VestingDisbursement) CancelledAmount() sdk.Coins {
+	cancelled := sdk.NewCoins()
+
+	for _, tranche := range v.Tranches {
+		if v.isForfeited(tranche) {
+			cancelled = cancelled.Add(tranche.Amount...)
+		}
+	}
+
+	return cancelled
+}
+
+func (v VestingDisbursement) String() string {
+	tranches := make([]string, len(v.Tranches))
+	for i, tranche := range v.Tranches {
+		tranches[i] = tranche.String()
+	}
+
+	return fmt.Sprintf(`
+	Operator: %s
+	Recipient: %s
+	Reference: %s
+	Amount: %s
+	CancelledAt: %s
+	Tranches:
+	  %s
+	`, v.Operator, v.Recipient, v.Reference, v.Amount, v.CancelledAt, strings.Join(tranches, "\n\t  "))
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/anathatech/project-anatha/x/treasury/client/cli"
 	"github.com/anathatech/project-anatha/x/treasury/client/rest"
+	"github.com/anathatech/project-anatha/x/treasury/orderbook"
 )
 
 var (
@@ -33,6 +34,7 @@ func (AppModuleBasic) Name() string {
 
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 	RegisterCodec(cdc)
+	orderbook.RegisterCodec(cdc)
 }
 
 func (AppModuleBasic) DefaultGenesis() json.RawMessage {
@@ -63,15 +65,17 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 	keeper     Keeper
+	orderBookKeeper orderbook.Keeper
 	accountKeeper auth.AccountKeeper
 	supplyKeeper supply.Keeper
 	bankKeeper bank.Keeper
 }
 
-func NewAppModule(k Keeper, accountKeeper auth.AccountKeeper, supplyKeeper supply.Keeper, bankKeeper bank.Keeper) AppModule {
+func NewAppModule(k Keeper, orderBookKeeper orderbook.Keeper, accountKeeper auth.AccountKeeper, supplyKeeper supply.Keeper, bankKeeper bank.Keeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         k,
+		orderBookKeeper: orderBookKeeper,
 		accountKeeper: accountKeeper,
 		supplyKeeper:  supplyKeeper,
 		bankKeeper: bankKeeper,
@@ -82,37 +86,50 @@ func (AppModule) Name() string {
 	return ModuleName
 }
 
-func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 func (am AppModule) Route() string {
 	return RouterKey
 }
 
 func (am AppModule) NewHandler() sdk.Handler {
-	return NewHandler(am.keeper)
+	return NewHandler(am.keeper, am.orderBookKeeper)
 }
 func (AppModule) QuerierRoute() string {
 	return QuerierRoute
 }
 
+// NewQuerierHandler serves the treasury and order book queries on the treasury querier route.
 func (am AppModule) NewQuerierHandler() sdk.Querier {
-	return NewQuerier(am.keeper)
+	treasuryQuerier := NewQuerier(am.keeper)
+	orderBookQuerier := orderbook.NewQuerier(am.orderBookKeeper)
+
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		if orderbook.IsQuery(path[0]) {
+			return orderBookQuerier(ctx, path, req)
+		}
+
+		return treasuryQuerier(ctx, path, req)
+	}
 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {}
 
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	EndBlocker(ctx, am.keeper)
+	orderbook.EndBlocker(ctx, am.orderBookKeeper)
 	return []abci.ValidatorUpdate{}
 }
 
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
 	ModuleCdc.MustUnmarshalJSON(data, &genesisState)
-	return InitGenesis(ctx, am.keeper, genesisState)
+	return InitGenesis(ctx, am.keeper, am.orderBookKeeper, genesisState)
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
-	gs := ExportGenesis(ctx, am.keeper)
+	gs := ExportGenesis(ctx, am.keeper, am.orderBookKeeper)
 	return ModuleCdc.MustMarshalJSON(gs)
 }
\ No newline at end of file
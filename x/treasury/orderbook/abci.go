@@ -0,0 +1,11 @@
+package orderbook
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker returns the escrow of expired orders before the remaining orders are matched.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.ExpireLimitOrders(ctx)
+	k.MatchLimitOrders(ctx)
+}
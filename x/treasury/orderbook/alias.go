@@ -0,0 +1,37 @@
+package orderbook
+
+import (
+	"github.com/anathatech/project-anatha/x/treasury/orderbook/keeper"
+	"github.com/anathatech/project-anatha/x/treasury/orderbook/types"
+)
+
+const (
+	EscrowModuleName = types.EscrowModuleName
+	OrderSideBuy     = types.OrderSideBuy
+	OrderSideSell    = types.OrderSideSell
+)
+
+var (
+	// functions aliases
+	NewKeeper              = keeper.NewKeeper
+	NewQuerier             = keeper.NewQuerier
+	IsQuery                = keeper.IsQuery
+	RegisterCodec          = types.RegisterCodec
+	NewGenesisState        = types.NewGenesisState
+	DefaultGenesisState    = types.DefaultGenesisState
+	ValidateGenesis        = types.ValidateGenesis
+	NewMsgPlaceLimitOrder  = types.NewMsgPlaceLimitOrder
+	NewMsgCancelLimitOrder = types.NewMsgCancelLimitOrder
+	NewLimitOrder          = types.NewLimitOrder
+
+	// variable aliases
+	ModuleCdc = types.ModuleCdc
+)
+
+type (
+	Keeper              = keeper.Keeper
+	GenesisState        = types.GenesisState
+	LimitOrder          = types.LimitOrder
+	MsgPlaceLimitOrder  = types.MsgPlaceLimitOrder
+	MsgCancelLimitOrder = types.MsgCancelLimitOrder
+)
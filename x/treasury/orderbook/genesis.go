@@ -0,0 +1,17 @@
+package orderbook
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, order := range data.LimitOrders {
+		k.InsertLimitOrder(ctx, order)
+	}
+
+	k.SetNextLimitOrderID(ctx, data.NextLimitOrderID)
+}
+
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetLimitOrders(ctx), k.GetNextLimitOrderID(ctx))
+}
@@ -0,0 +1,46 @@
+package orderbook
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/anathatech/project-anatha/x/treasury/orderbook/types"
+)
+
+// NewHandler handles the order book msgs, the treasury handler routes them here.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+
+		case MsgPlaceLimitOrder:
+			return handleMsgPlaceLimitOrder(ctx, k, msg)
+
+		case MsgCancelLimitOrder:
+			return handleMsgCancelLimitOrder(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s order book message type: %T", types.ModuleName, msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleMsgPlaceLimitOrder(ctx sdk.Context, k Keeper, msg MsgPlaceLimitOrder) (*sdk.Result, error) {
+	err := k.HandlePlaceLimitOrder(ctx, msg.Owner, msg.Side, msg.Amount, msg.Price, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelLimitOrder(ctx sdk.Context, k Keeper, msg MsgCancelLimitOrder) (*sdk.Result, error) {
+	err := k.HandleCancelLimitOrder(ctx, msg.Owner, msg.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	treasury "github.com/anathatech/project-anatha/x/treasury/internal/types"
+)
+
+// TreasuryKeeper defines the treasury functions the order book routes orders to and moves escrow with
+type TreasuryKeeper interface {
+	QuoteBuyOrder(ctx sdk.Context, dinAmount sdk.Coins) treasury.QueryResQuote
+	QuoteSellOrder(ctx sdk.Context, pinAmount sdk.Coins) treasury.QueryResQuote
+	HandleCreateBuyOrder(ctx sdk.Context, buyer sdk.AccAddress, dinAmount sdk.Coins, minReceived sdk.Coins, maxPrice sdk.Dec) error
+	HandleCreateSellOrder(ctx sdk.Context, sender sdk.AccAddress, pinAmount sdk.Coins, minReceived sdk.Coins) error
+	MaxLimitOrderDuration(ctx sdk.Context) time.Duration
+
+	SendFromAccountToModule(ctx sdk.Context, category string, reference string, sender sdk.AccAddress, recipient string, amount sdk.Coins) error
+	SendFromModuleToAccount(ctx sdk.Context, category string, reference string, sender string, recipient sdk.AccAddress, amount sdk.Coins) error
+}
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool
+}
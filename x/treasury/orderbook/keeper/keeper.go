@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/anathatech/project-anatha/x/treasury/orderbook/types"
+)
+
+// Keeper of the order book. It shares the treasury store, the order book keys have their own prefixes.
+type Keeper struct {
+	storeKey 		sdk.StoreKey
+	cdc 			*codec.Codec
+	treasuryKeeper 	TreasuryKeeper
+	bankKeeper 		BankKeeper
+}
+
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, treasuryKeeper TreasuryKeeper, bankKeeper BankKeeper) Keeper {
+	return Keeper{
+		storeKey: 		key,
+		cdc: 			cdc,
+		treasuryKeeper: treasuryKeeper,
+		bankKeeper: 	bankKeeper,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s/orderbook", types.ModuleName))
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/anathatech/project-anatha/config"
+	treasurykeeper "github.com/anathatech/project-anatha/x/treasury/internal/keeper"
+	"github.com/anathatech/project-anatha/x/treasury/orderbook/types"
+)
+
+var (
+	testAddrs = []sdk.AccAddress{
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	}
+)
+
+type testInput struct {
+	ctx            sdk.Context
+	keeper         Keeper
+	treasuryKeeper treasurykeeper.Keeper
+	bankKeeper     bank.Keeper
+	supplyKeeper   supply.Keeper
+}
+
+// createTestInput sets up the order book on top of a treasury with the default treasury and params. The treasury
+// module account holds the whole target supply and the treasury sells its first stage at 1 DIN per PIN. Sell orders
+// are bought back at a tenth of the price, so that limit orders between the two prices rest in the book.
+func createTestInput(t *testing.T) testInput {
+	input := treasurykeeper.CreateTestInput(t)
+
+	p := input.Keeper.GetParams(input.Ctx)
+	p.BuyBackPercentage = sdk.NewDecWithPrec(1, 1)
+	input.Keeper.SetParams(input.Ctx, p)
+
+	keeper := NewKeeper(input.Cdc, input.StoreKey, input.Keeper, input.BankKeeper)
+
+	return testInput{
+		ctx:            input.Ctx,
+		keeper:         keeper,
+		treasuryKeeper: input.Keeper,
+		bankKeeper:     input.BankKeeper,
+		supplyKeeper:   input.SupplyKeeper,
+	}
+}
+
+func din(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(config.DefaultStableDenom, amount))
+}
+
+func pin(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, amount))
+}
+
+// fund adds coins to an account, creating the account if needed.
+func fund(t *testing.T, input testInput, address sdk.AccAddress, amount sdk.Coins) {
+	_, err := input.bankKeeper.AddCoins(input.ctx, address, amount)
+	require.NoError(t, err)
+}
+
+func escrowBalance(input testInput) sdk.Coins {
+	return input.supplyKeeper.GetModuleAccount(input.ctx, types.EscrowModuleName).GetCoins()
+}
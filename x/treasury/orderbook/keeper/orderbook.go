@@ -0,0 +1,536 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/anathatech/project-anatha/config"
+	treasury "github.com/anathatech/project-anatha/x/treasury/internal/types"
+	"github.com/anathatech/project-anatha/x/treasury/orderbook/types"
+)
+
+// HandlePlaceLimitOrder fills as much of the order from the treasury as the treasury fills within the limit and at
+// least as good as the best opposite order in the book. The rest of the order is escrowed and rests in the book until
+// it is matched, cancelled or expires. Resting orders are only matched against each other, the treasury is offered
+// the order once when it is placed.
+func (k Keeper) HandlePlaceLimitOrder(ctx sdk.Context, owner sdk.AccAddress, side string, amount sdk.Coins, price sdk.Dec, duration time.Duration) error {
+	if duration <= 0 {
+		return sdkerrors.Wrap(types.ErrInvalidLimitOrder, "Duration must be positive.")
+	}
+
+	if duration > k.treasuryKeeper.MaxLimitOrderDuration(ctx) {
+		return sdkerrors.Wrapf(types.ErrInvalidLimitOrder, "Duration exceeds the maximum of %s.", k.treasuryKeeper.MaxLimitOrderDuration(ctx))
+	}
+
+	if ! k.bankKeeper.HasCoins(ctx, owner, amount) {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "Insufficient funds for the limit order.")
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySide, side),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+	}
+
+	remaining := amount
+
+	fromTreasury := k.treasuryFillAmount(ctx, side, amount, price)
+	if ! fromTreasury.IsZero() && k.fillLimitOrderFromTreasury(ctx, owner, side, fromTreasury, price) {
+		remaining = amount.Sub(fromTreasury)
+
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyTreasuryAmount, fromTreasury.String()))
+	}
+
+	if remaining.IsZero() {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyRoute, types.LimitOrderRouteTreasury))
+	} else {
+		order := types.NewLimitOrder(k.GetNextLimitOrderID(ctx), owner, side, price, remaining, ctx.BlockTime(), ctx.BlockTime().Add(duration))
+
+		err := k.treasuryKeeper.SendFromAccountToModule(ctx, treasury.LedgerCategoryLimitOrder, orderReference(order.ID), owner, types.EscrowModuleName, remaining)
+		if err != nil {
+			return err
+		}
+
+		k.SetNextLimitOrderID(ctx, order.ID + 1)
+		k.InsertLimitOrder(ctx, order)
+
+		route := types.LimitOrderRouteBook
+		if ! remaining.IsEqual(amount) {
+			route = types.LimitOrderRouteSplit
+		}
+
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyRoute, route),
+			sdk.NewAttribute(types.AttributeKeyOrderID, sdk.NewUint(order.ID).String()),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, order.ExpiresAt.String()),
+		)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypePlaceLimitOrder, attributes...),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) HandleCancelLimitOrder(ctx sdk.Context, owner sdk.AccAddress, id uint64) error {
+	order, found := k.GetLimitOrder(ctx, id)
+	if ! found {
+		return types.ErrLimitOrderNotFound
+	}
+
+	if ! order.Owner.Equals(owner) {
+		return types.ErrNotLimitOrderOwner
+	}
+
+	err := k.closeLimitOrder(ctx, order, types.EventTypeCancelLimitOrder)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return nil
+}
+
+// treasuryFillAmount returns the largest part of the order the treasury fills within the limit and at least as good
+// as the best opposite order in the book. The average treasury price only gets worse as the amount grows, buys walk up
+// and sells walk down the bonding curve, so the amount is found with a binary search over the escrowed denomination.
+func (k Keeper) treasuryFillAmount(ctx sdk.Context, side string, amount sdk.Coins, price sdk.Dec) sdk.Coins {
+	denom := config.DefaultDenom
+	if side == types.OrderSideBuy {
+		denom = config.DefaultStableDenom
+	}
+
+	coins := func(amount sdk.Int) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(denom, amount))
+	}
+
+	total := amount.AmountOf(denom)
+	if k.treasuryFillsLimitOrder(ctx, side, coins(total), price) {
+		return amount
+	}
+
+	low := sdk.ZeroInt()
+	high := total.Sub(sdk.OneInt())
+
+	for low.LT(high) {
+		middle := low.Add(high).Add(sdk.OneInt()).QuoRaw(2)
+
+		if k.treasuryFillsLimitOrder(ctx, side, coins(middle), price) {
+			low = middle
+		} else {
+			high = middle.Sub(sdk.OneInt())
+		}
+	}
+
+	if ! low.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	return coins(low)
+}
+
+// treasuryFillsLimitOrder returns true if the treasury fills the amount within the limit and at least as good as the
+// best opposite order in the book.
+func (k Keeper) treasuryFillsLimitOrder(ctx sdk.Context, side string, amount sdk.Coins, price sdk.Dec) bool {
+	if side == types.OrderSideBuy {
+		quote := k.treasuryKeeper.QuoteBuyOrder(ctx, amount)
+		if quote.Received.IsZero() || quote.AveragePrice.GT(price) {
+			return false
+		}
+
+		ask, found := k.GetBestLimitOrder(ctx, types.OrderSideSell)
+
+		return ! found || quote.AveragePrice.LTE(ask.Price)
+	}
+
+	quote := k.treasuryKeeper.QuoteSellOrder(ctx, amount)
+	if quote.Received.IsZero() || quote.AveragePrice.LT(price) {
+		return false
+	}
+
+	bid, found := k.GetBestLimitOrder(ctx, types.OrderSideBuy)
+
+	return ! found || quote.AveragePrice.GTE(bid.Price)
+}
+
+// fillLimitOrderFromTreasury executes the amount as a market order against the treasury. Nothing is changed and false
+// is returned if the treasury can't fill it, the whole order then goes to the book.
+func (k Keeper) fillLimitOrderFromTreasury(ctx sdk.Context, owner sdk.AccAddress, side string, amount sdk.Coins, price sdk.Dec) bool {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	var err error
+	if side == types.OrderSideBuy {
+		err = k.treasuryKeeper.HandleCreateBuyOrder(cacheCtx, owner, amount, sdk.NewCoins(), price)
+	} else {
+		err = k.treasuryKeeper.HandleCreateSellOrder(cacheCtx, owner, amount, sdk.NewCoins())
+	}
+
+	if err != nil {
+		return false
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return true
+}
+
+// MatchLimitOrders crosses the best bid with the best ask until the book is no longer crossed or MaxMatchesPerBlock
+// fills and closes were done. Trades execute at the price of the older order, which was resting in the book first.
+// A fill which fails closes both orders and returns their escrow. An order which can't be closed either is skipped for
+// the rest of the block, so that it doesn't hold up the orders behind it, and is retried in the next block.
+func (k Keeper) MatchLimitOrders(ctx sdk.Context) {
+	skipped := make(map[uint64]bool)
+
+	for i := 0; i < types.MaxMatchesPerBlock; i++ {
+		bid, foundBid := k.getBestLimitOrder(ctx, types.OrderSideBuy, skipped)
+		ask, foundAsk := k.getBestLimitOrder(ctx, types.OrderSideSell, skipped)
+
+		if ! foundBid || ! foundAsk || bid.Price.LT(ask.Price) {
+			return
+		}
+
+		price := ask.Price
+		if bid.ID < ask.ID {
+			price = bid.Price
+		}
+
+		pinAmount := sdk.MinInt(ask.RemainingAmount(), bid.RemainingAmount().ToDec().Quo(price).TruncateInt())
+		dinAmount := pinAmount.ToDec().Mul(price).Ceil().TruncateInt()
+
+		if dinAmount.GT(bid.RemainingAmount()) {
+			// the quotient was rounded up to the next PIN
+			pinAmount = pinAmount.Sub(sdk.OneInt())
+			dinAmount = pinAmount.ToDec().Mul(price).Ceil().TruncateInt()
+		}
+
+		if ! pinAmount.IsPositive() {
+			// what is left of the bid can't buy a single PIN anymore
+			k.closeOrSkipLimitOrder(ctx, bid, skipped)
+			continue
+		}
+
+		ok := k.runEndBlockAction(ctx, types.EventTypeFillLimitOrder, bid.ID, func(cacheCtx sdk.Context) error {
+			return k.fillLimitOrders(cacheCtx, bid, ask, pinAmount, dinAmount, price)
+		})
+
+		if ! ok {
+			k.closeOrSkipLimitOrder(ctx, bid, skipped)
+			k.closeOrSkipLimitOrder(ctx, ask, skipped)
+		}
+	}
+}
+
+// closeOrSkipLimitOrder closes the order and returns its escrow, the order is skipped by matching if that fails.
+func (k Keeper) closeOrSkipLimitOrder(ctx sdk.Context, order types.LimitOrder, skipped map[uint64]bool) {
+	ok := k.runEndBlockAction(ctx, types.EventTypeCloseLimitOrder, order.ID, func(cacheCtx sdk.Context) error {
+		return k.closeLimitOrder(cacheCtx, order, types.EventTypeCloseLimitOrder)
+	})
+
+	if ! ok {
+		skipped[order.ID] = true
+	}
+}
+
+func (k Keeper) fillLimitOrders(ctx sdk.Context, bid types.LimitOrder, ask types.LimitOrder, pinAmount sdk.Int, dinAmount sdk.Int, price sdk.Dec) error {
+	pinCoins := sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, pinAmount))
+	dinCoins := sdk.NewCoins(sdk.NewCoin(config.DefaultStableDenom, dinAmount))
+
+	err := k.treasuryKeeper.SendFromModuleToAccount(ctx, treasury.LedgerCategoryLimitOrder, orderReference(bid.ID), types.EscrowModuleName, bid.Owner, pinCoins)
+	if err != nil {
+		return err
+	}
+
+	err = k.treasuryKeeper.SendFromModuleToAccount(ctx, treasury.LedgerCategoryLimitOrder, orderReference(ask.ID), types.EscrowModuleName, ask.Owner, dinCoins)
+	if err != nil {
+		return err
+	}
+
+	bid.Remaining = bid.Remaining.Sub(dinCoins)
+	bid.Filled = bid.Filled.Add(pinCoins...)
+
+	ask.Remaining = ask.Remaining.Sub(pinCoins)
+	ask.Filled = ask.Filled.Add(dinCoins...)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFillLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyBuyOrderID, sdk.NewUint(bid.ID).String()),
+			sdk.NewAttribute(types.AttributeKeySellOrderID, sdk.NewUint(ask.ID).String()),
+			sdk.NewAttribute(types.AttributeKeyPinAmount, pinCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyDinAmount, dinCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	for _, order := range []types.LimitOrder{bid, ask} {
+		if order.RemainingAmount().IsZero() {
+			err = k.closeLimitOrder(ctx, order, types.EventTypeCloseLimitOrder)
+			if err != nil {
+				return err
+			}
+		} else {
+			k.SetLimitOrder(ctx, order)
+		}
+	}
+
+	return nil
+}
+
+// ExpireLimitOrders removes the orders which expired by the current block time and returns their escrow. An order
+// which fails to close stays in the queue and is retried in the next block.
+func (k Keeper) ExpireLimitOrders(ctx sdk.Context) {
+	var expired []types.LimitOrder
+
+	k.IterateLimitOrderQueue(ctx, ctx.BlockTime(), func(order types.LimitOrder) (stop bool) {
+		expired = append(expired, order)
+		return false
+	})
+
+	for _, order := range expired {
+		k.runEndBlockAction(ctx, types.EventTypeExpireLimitOrder, order.ID, func(cacheCtx sdk.Context) error {
+			return k.closeLimitOrder(cacheCtx, order, types.EventTypeExpireLimitOrder)
+		})
+	}
+}
+
+// runEndBlockAction runs an end block action on an order in a cached context so a failing action leaves no partial
+// changes behind. It returns false if the action failed.
+func (k Keeper) runEndBlockAction(ctx sdk.Context, action string, id uint64, process func(cacheCtx sdk.Context) error) bool {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	err := process(cacheCtx)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("%s of limit order %d failed: %s", action, id, err.Error()))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLimitOrderFailed,
+				sdk.NewAttribute(types.AttributeKeyAction, action),
+				sdk.NewAttribute(types.AttributeKeyOrderID, sdk.NewUint(id).String()),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			),
+		)
+
+		return false
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return true
+}
+
+// closeLimitOrder returns the remaining escrow to the owner and removes the order from the book.
+func (k Keeper) closeLimitOrder(ctx sdk.Context, order types.LimitOrder, eventType string) error {
+	if ! order.Remaining.IsZero() {
+		err := k.treasuryKeeper.SendFromModuleToAccount(ctx, treasury.LedgerCategoryLimitOrder, orderReference(order.ID), types.EscrowModuleName, order.Owner, order.Remaining)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.RemoveLimitOrder(ctx, order)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyOrderID, sdk.NewUint(order.ID).String()),
+			sdk.NewAttribute(types.AttributeKeyOwner, order.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, order.Remaining.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) GetNextLimitOrderID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NextLimitOrderIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextLimitOrderID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.NextLimitOrderIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) GetLimitOrder(ctx sdk.Context, id uint64) (types.LimitOrder, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetLimitOrderKey(id))
+	if bz == nil {
+		return types.LimitOrder{}, false
+	}
+
+	var order types.LimitOrder
+	k.cdc.MustUnmarshalBinaryBare(bz, &order)
+
+	return order, true
+}
+
+// SetLimitOrder stores the order. Use InsertLimitOrder to add it to the book.
+func (k Keeper) SetLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetLimitOrderKey(order.ID), k.cdc.MustMarshalBinaryBare(order))
+}
+
+func (k Keeper) InsertLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+
+	k.SetLimitOrder(ctx, order)
+
+	store.Set(types.OrderBookKey(order), sdk.Uint64ToBigEndian(order.ID))
+	store.Set(types.LimitOrderQueueKey(order.ID, order.ExpiresAt), sdk.Uint64ToBigEndian(order.ID))
+}
+
+func (k Keeper) RemoveLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetLimitOrderKey(order.ID))
+	store.Delete(types.OrderBookKey(order))
+	store.Delete(types.LimitOrderQueueKey(order.ID, order.ExpiresAt))
+}
+
+// GetBestLimitOrder returns the highest bid or the lowest ask, the oldest one if several share the price.
+func (k Keeper) GetBestLimitOrder(ctx sdk.Context, side string) (best types.LimitOrder, found bool) {
+	k.IterateOrderBook(ctx, side, func(order types.LimitOrder) (stop bool) {
+		best = order
+		found = true
+		return true
+	})
+
+	return
+}
+
+// getBestLimitOrder returns the best order of the side which is not skipped.
+func (k Keeper) getBestLimitOrder(ctx sdk.Context, side string, skipped map[uint64]bool) (best types.LimitOrder, found bool) {
+	k.IterateOrderBook(ctx, side, func(order types.LimitOrder) (stop bool) {
+		if skipped[order.ID] {
+			return false
+		}
+
+		best = order
+		found = true
+		return true
+	})
+
+	return
+}
+
+// IterateOrderBook iterates the bids or asks by price-time priority.
+func (k Keeper) IterateOrderBook(ctx sdk.Context, side string, cb func(order types.LimitOrder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.OrderBookSideKey(side))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		order, found := k.GetLimitOrder(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if ! found {
+			panic("order book references a missing limit order")
+		}
+
+		if cb(order) {
+			break
+		}
+	}
+}
+
+// IterateLimitOrderQueue iterates the orders which expire up to endTime.
+func (k Keeper) IterateLimitOrderQueue(ctx sdk.Context, endTime time.Time, cb func(order types.LimitOrder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.LimitOrderQueueKeyPrefix, sdk.PrefixEndBytes(types.LimitOrderByTimeKey(endTime)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		order, found := k.GetLimitOrder(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if ! found {
+			panic("limit order queue references a missing limit order")
+		}
+
+		if cb(order) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateLimitOrders(ctx sdk.Context, cb func(order types.LimitOrder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.LimitOrderKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.LimitOrder
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &order)
+
+		if cb(order) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetLimitOrders(ctx sdk.Context) []types.LimitOrder {
+	orders := []types.LimitOrder{}
+	k.IterateLimitOrders(ctx, func(order types.LimitOrder) (stop bool) {
+		orders = append(orders, order)
+		return false
+	})
+
+	return orders
+}
+
+func (k Keeper) GetLimitOrdersByOwner(ctx sdk.Context, owner sdk.AccAddress) []types.LimitOrder {
+	orders := []types.LimitOrder{}
+	k.IterateLimitOrders(ctx, func(order types.LimitOrder) (stop bool) {
+		if order.Owner.Equals(owner) {
+			orders = append(orders, order)
+		}
+		return false
+	})
+
+	return orders
+}
+
+func (k Keeper) GetOrderBookSide(ctx sdk.Context, side string) []types.LimitOrder {
+	orders := []types.LimitOrder{}
+	k.IterateOrderBook(ctx, side, func(order types.LimitOrder) (stop bool) {
+		orders = append(orders, order)
+		return false
+	})
+
+	return orders
+}
+
+// orderReference is the ledger reference of the escrow movements of a limit order.
+func orderReference(id uint64) string {
+	return "limit-order/" + sdk.NewUint(id).String()
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anathatech/project-anatha/x/treasury/orderbook/types"
+)
+
+func TestCrossedOrdersMatchAtTheOlderPrice(t *testing.T) {
+	input := createTestInput(t)
+	seller, buyer := testAddrs[0], testAddrs[1]
+
+	fund(t, input, seller, pin(1000))
+	fund(t, input, buyer, din(300))
+
+	require.NoError(t, input.keeper.HandlePlaceLimitOrder(input.ctx, seller, types.OrderSideSell, pin(1000), sdk.NewDecWithPrec(5, 1), time.Hour))
+	require.NoError(t, input.keeper.HandlePlaceLimitOrder(input.ctx, buyer, types.OrderSideBuy, din(300), sdk.NewDecWithPrec(6, 1), time.Hour))
+
+	// both orders were priced away from the treasury and rest in the book
+	require.Len(t, input.keeper.GetLimitOrders(input.ctx), 2)
+	require.Equal(t, din(300).Add(pin(1000)...), escrowBalance(input))
+
+	input.keeper.MatchLimitOrders(input.ctx)
+
+	// the sell order was resting first, so 300 DIN buy 600 PIN at its price and fill the buy order
+	require.Equal(t, pin(600), input.bankKeeper.GetCoins(input.ctx, buyer))
+	require.Equal(t, din(300), input.bankKeeper.GetCoins(input.ctx, seller))
+
+	_, found := input.keeper.GetLimitOrder(input.ctx, 2)
+	require.False(t, found)
+
+	ask, found := input.keeper.GetLimitOrder(input.ctx, 1)
+	require.True(t, found)
+	require.Equal(t, pin(400), ask.Remaining)
+	require.Equal(t, din(300), ask.Filled)
+	require.Equal(t, pin(400), escrowBalance(input))
+}
+
+func TestFailedFillDoesNotStopMatching(t *testing.T) {
+	input := createTestInput(t)
+	seller, buyer, otherSeller, otherBuyer := testAddrs[0], testAddrs[1], testAddrs[2], testAddrs[3]
+
+	fund(t, input, seller, pin(1000))
+	fund(t, input, buyer, din(300))
+	fund(t, input, otherBuyer, din(100))
+	fund(t, input, otherSeller, pin(200))
+
+	require.NoError(t, input.keeper.HandlePlaceLimitOrder(input.ctx, seller, types.OrderSideSell, pin(1000), sdk.NewDecWithPrec(5, 1), time.Hour))
+	require.NoError(t, input.keeper.HandlePlaceLimitOrder(input.ctx, buyer, types.OrderSideBuy, din(300), sdk.NewDecWithPrec(6, 1), time.Hour))
+	require.NoError(t, input.keeper.HandlePlaceLimitOrder(input.ctx, otherBuyer, types.OrderSideBuy, din(100), sdk.NewDecWithPrec(55, 2), time.Hour))
+	require.NoError(t, input.keeper.HandlePlaceLimitOrder(input.ctx, otherSeller, types.OrderSideSell, pin(200), sdk.NewDecWithPrec(5, 1), time.Hour))
+
+	// the best bid claims more than the escrow holds, so neither its fill nor its close can be paid
+	broken, found := input.keeper.GetLimitOrder(input.ctx, 2)
+	require.True(t, found)
+	broken.Remaining = din(100000)
+	input.keeper.SetLimitOrder(input.ctx, broken)
+
+	input.keeper.MatchLimitOrders(input.ctx)
+
+	// the ask of the failed fill was closed and refunded, the broken bid stays in the book
+	_, found = input.keeper.GetLimitOrder(input.ctx, 1)
+	require.False(t, found)
+	require.Equal(t, pin(1000), input.bankKeeper.GetCoins(input.ctx, seller))
+
+	_, found = input.keeper.GetLimitOrder(input.ctx, 2)
+	require.True(t, found)
+
+	// the orders behind the broken bid still match, at the price of the older bid
+	require.Equal(t, pin(181), input.bankKeeper.GetCoins(input.ctx, otherBuyer))
+	require.Equal(t, din(100), input.bankKeeper.GetCoins(input.ctx, otherSeller))
+
+	_, found = input.keeper.GetLimitOrder(input.ctx, 3)
+	require.False(t, found)
+
+	ask, found := input.keeper.GetLimitOrder(input.ctx, 4)
+	require.True(t, found)
+	require.Equal(t, pin(19), ask.Remaining)
+}
+
+func TestOrdersOutsideTheSpreadDoNotMatch(t *testing.T) {
+	input := createTestInput(t)
+	seller, buyer := testAddrs[0], testAddrs[1]
+
+	fund(t, input, seller, pin(1000))
+	fund(t, input, buyer, din(300))
+
+	require.NoError(t, input.keeper.HandlePlaceLimitOrder(input.ctx, seller, types.OrderSideSell, pin(1000), sdk.NewDecWithPrec(5, 1), time.Hour))
+	require.NoError(t, input.keeper.HandlePlaceLimitOrder(input.ctx, buyer, types.OrderSideBuy, din(300), sdk.NewDecWithPrec(4, 1), time.Hour))
+
+	input.keeper.MatchLimitOrders(input.ctx)
+
+	require.Len(t, input.keeper.GetLimitOrders(input.ctx), 2)
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, seller).IsZero())
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, buyer).IsZero())
+}
+
+func TestExpiredAndCancelledOrdersReturnTheirEscrow(t *testing.T) {
+	input := createTestInput(t)
+	seller, buyer := testAddrs[0], testAddrs[1]
+
+	fund(t, input, seller, pin(1000))
+	fund(t, input, buyer, din(300))
+
+	require.NoError(t, input.keeper.HandlePlaceLimitOrder(input.ctx, seller, types.OrderSideSell, pin(1000), sdk.NewDecWithPrec(5, 1), time.Hour))
+	require.NoError(t, input.keeper.HandlePlaceLimitOrder(input.ctx, buyer, types.OrderSideBuy, din(300), sdk.NewDecWithPrec(4, 1), 2 * time.Hour))
+
+	require.Equal(t, types.ErrNotLimitOrderOwner, input.keeper.HandleCancelLimitOrder(input.ctx, seller, 2))
+	require.Equal(t, types.ErrLimitOrderNotFound, input.keeper.HandleCancelLimitOrder(input.ctx, seller, 3))
+
+	// only the sell order expired
+	ctx := input.ctx.WithBlockTime(input.ctx.BlockTime().Add(time.Hour))
+	input.keeper.ExpireLimitOrders(ctx)
+
+	require.Equal(t, pin(1000), input.bankKeeper.GetCoins(ctx, seller))
+	require.Len(t, input.keeper.GetLimitOrders(ctx), 1)
+
+	require.NoError(t, input.keeper.HandleCancelLimitOrder(ctx, buyer, 2))
+	require.Equal(t, din(300), input.bankKeeper.GetCoins(ctx, buyer))
+	require.Empty(t, input.keeper.GetLimitOrders(ctx))
+	require.True(t, escrowBalance(input).IsZero())
+}
+
+func TestLimitOrderWithinTheTreasuryPriceIsFilledByTheTreasury(t *testing.T) {
+	input := createTestInput(t)
+	buyer := testAddrs[0]
+
+	fund(t, input, buyer, din(1000))
+
+	require.Error(t, input.keeper.HandlePlaceLimitOrder(input.ctx, buyer, types.OrderSideBuy, din(1000), sdk.NewDec(2), input.treasuryKeeper.MaxLimitOrderDuration(input.ctx) + time.Second))
+	require.Error(t, input.keeper.HandlePlaceLimitOrder(input.ctx, buyer, types.OrderSideBuy, din(2000), sdk.NewDec(2), time.Hour))
+
+	require.NoError(t, input.keeper.HandlePlaceLimitOrder(input.ctx, buyer, types.OrderSideBuy, din(1000), sdk.NewDec(2), time.Hour))
+
+	require.Equal(t, pin(1000), input.bankKeeper.GetCoins(input.ctx, buyer))
+	require.Empty(t, input.keeper.GetLimitOrders(input.ctx))
+}
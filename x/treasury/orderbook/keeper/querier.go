@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"strconv"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/anathatech/project-anatha/x/treasury/orderbook/types"
+)
+
+// The order book queries are served on the treasury querier route.
+const (
+	QueryOrderBook = "order-book"
+	QueryLimitOrder = "limit-order"
+	QueryLimitOrders = "limit-orders"
+)
+
+// NewQuerier creates a new querier for order book clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case QueryOrderBook:
+			return queryOrderBook(ctx, k)
+		case QueryLimitOrder:
+			return queryLimitOrder(ctx, path[1:], req, k)
+		case QueryLimitOrders:
+			return queryLimitOrders(ctx, path[1:], req, k)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown order book query endpoint")
+		}
+	}
+}
+
+// IsQuery returns true if the query endpoint belongs to the order book.
+func IsQuery(endpoint string) bool {
+	return endpoint == QueryOrderBook || endpoint == QueryLimitOrder || endpoint == QueryLimitOrders
+}
+
+func queryOrderBook(ctx sdk.Context, k Keeper) ([]byte, error) {
+	orderBook := types.QueryResOrderBook{
+		Bids: k.GetOrderBookSide(ctx, types.OrderSideBuy),
+		Asks: k.GetOrderBookSide(ctx, types.OrderSideSell),
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, orderBook)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryLimitOrder(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	id, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrLimitOrderNotFound, path[0])
+	}
+
+	order, found := k.GetLimitOrder(ctx, id)
+	if ! found {
+		return nil, types.ErrLimitOrderNotFound
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, order)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryLimitOrders(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	owner, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, path[0])
+	}
+
+	orders := k.GetLimitOrdersByOwner(ctx, owner)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, orders)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
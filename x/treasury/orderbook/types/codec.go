@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgPlaceLimitOrder{}, "treasury/PlaceLimitOrder", nil)
+	cdc.RegisterConcrete(MsgCancelLimitOrder{}, "treasury/CancelLimitOrder", nil)
+}
+
+// ModuleCdc defines the order book codec
+var ModuleCdc = codec.New()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+
+	codec.RegisterCrypto(ModuleCdc)
+
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// The order book errors are registered in the treasury codespace, next to the treasury errors.
+var (
+	ErrLimitOrderNotFound = sdkerrors.Register(ModuleName, 122, "Limit order not found")
+	ErrNotLimitOrderOwner = sdkerrors.Register(ModuleName, 123, "Not the owner of the limit order")
+	ErrInvalidLimitOrder = sdkerrors.Register(ModuleName, 124, "Invalid limit order")
+)
@@ -0,0 +1,28 @@
+package types
+
+const (
+	EventTypePlaceLimitOrder	= "place_limit_order"
+	EventTypeCancelLimitOrder	= "cancel_limit_order"
+	EventTypeExpireLimitOrder	= "expire_limit_order"
+	EventTypeCloseLimitOrder	= "close_limit_order"
+	EventTypeFillLimitOrder		= "fill_limit_order"
+	EventTypeLimitOrderFailed	= "limit_order_failed"
+
+	AttributeKeySender			= "sender"
+	AttributeKeyAmount			= "amount"
+	AttributeKeyPinAmount		= "pin_amount"
+	AttributeKeyDinAmount		= "din_amount"
+	AttributeKeyReason			= "reason"
+	AttributeKeyAction			= "action"
+	AttributeKeyOrderID			= "order_id"
+	AttributeKeyOwner			= "owner"
+	AttributeKeySide			= "side"
+	AttributeKeyPrice			= "price"
+	AttributeKeyRoute			= "route"
+	AttributeKeyTreasuryAmount	= "treasury_amount"
+	AttributeKeyBuyOrderID		= "buy_order_id"
+	AttributeKeySellOrderID		= "sell_order_id"
+	AttributeKeyExpiresAt		= "expires_at"
+
+	AttributeValueModule = ModuleName
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState - the resting limit orders of the order book, part of the treasury genesis
+type GenesisState struct {
+	LimitOrders []LimitOrder `json:"limit_orders" yaml:"limit_orders"`
+	NextLimitOrderID uint64 `json:"next_limit_order_id" yaml:"next_limit_order_id"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(limitOrders []LimitOrder, nextLimitOrderID uint64) GenesisState {
+	return GenesisState{
+		LimitOrders: limitOrders,
+		NextLimitOrderID: nextLimitOrderID,
+	}
+}
+
+// DefaultGenesisState - an empty order book
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		LimitOrders: []LimitOrder{},
+		NextLimitOrderID: 1,
+	}
+}
+
+// ValidateGenesis validates the order book genesis state
+func ValidateGenesis(data GenesisState) error {
+	for _, order := range data.LimitOrders {
+		if order.Owner.Empty() {
+			return fmt.Errorf("invalid limit order %d: missing owner", order.ID)
+		}
+		if err := validateOrderSide(order.Side); err != nil {
+			return err
+		}
+		if err := validateLimitOrderPrice(order.Price); err != nil {
+			return err
+		}
+		if order.ID >= data.NextLimitOrderID {
+			return fmt.Errorf("invalid limit order %d: id must be lower than the next limit order id %d", order.ID, data.NextLimitOrderID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"time"
+)
+
+// The order book is part of the treasury module, it keeps its state in the treasury store and shares the treasury
+// route, querier route and error codespace.
+var (
+	LimitOrderKeyPrefix      = []byte{0x18}
+	OrderBookKeyPrefix       = []byte{0x19}
+	LimitOrderQueueKeyPrefix = []byte{0x1A}
+	NextLimitOrderIDKey      = []byte{0x1B}
+)
+
+const lenPrice = 32
+
+const (
+	// ModuleName is the name of the treasury module the order book belongs to
+	ModuleName = "treasury"
+
+	// StoreKey of the treasury store the order book is kept in
+	StoreKey = ModuleName
+
+	// RouterKey to be used for routing msgs
+	RouterKey = ModuleName
+
+	// QuerierRoute to be used for querierer msgs
+	QuerierRoute = ModuleName
+
+	EscrowModuleName = "treasury_order_book" // Module stores the DIN and PIN of resting limit orders
+)
+
+func GetLimitOrderKey(id uint64) []byte {
+	return append(LimitOrderKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// OrderBookSideKey returns the prefix of the bids or asks of the order book.
+func OrderBookSideKey(side string) []byte {
+	if side == OrderSideBuy {
+		return append(OrderBookKeyPrefix, 0x01)
+	}
+
+	return append(OrderBookKeyPrefix, 0x02)
+}
+
+// OrderBookKey orders the bids and asks by price-time priority. Bid prices are inverted so both sides iterate from
+// the best price, orders with the same price iterate from the oldest.
+func OrderBookKey(order LimitOrder) []byte {
+	price := OrderBookPrice(order.Price)
+
+	if order.IsBuy() {
+		for i := range price {
+			price[i] = ^price[i]
+		}
+	}
+
+	return append(append(OrderBookSideKey(order.Side), price...), sdk.Uint64ToBigEndian(order.ID)...)
+}
+
+func LimitOrderByTimeKey(expiresAt time.Time) []byte {
+	return append(LimitOrderQueueKeyPrefix, sdk.FormatTimeBytes(expiresAt)...)
+}
+
+func LimitOrderQueueKey(id uint64, expiresAt time.Time) []byte {
+	return append(LimitOrderByTimeKey(expiresAt), sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/anathatech/project-anatha/config"
+)
+
+// MsgPlaceLimitOrder
+// Buy orders spend Amount DIN and sell orders sell Amount PIN at Price DIN per PIN or better.
+type MsgPlaceLimitOrder struct {
+	Owner 		sdk.AccAddress 	`json:"owner" yaml:"owner"`
+	Side 		string 			`json:"side" yaml:"side"`
+	Amount 		sdk.Coins 		`json:"amount" yaml:"amount"`
+	Price 		sdk.Dec 		`json:"price" yaml:"price"`
+	Duration 	time.Duration 	`json:"duration" yaml:"duration"`
+}
+
+func NewMsgPlaceLimitOrder(owner sdk.AccAddress, side string, amount sdk.Coins, price sdk.Dec, duration time.Duration) MsgPlaceLimitOrder {
+	return MsgPlaceLimitOrder{
+		Owner: owner,
+		Side: side,
+		Amount: amount,
+		Price: price,
+		Duration: duration,
+	}
+}
+
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+
+func (msg MsgPlaceLimitOrder) Type() string { return "place_limit_order" }
+
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if err := validateOrderSide(msg.Side); err != nil {
+		return sdkerrors.Wrap(ErrInvalidLimitOrder, err.Error())
+	}
+	denom := config.DefaultDenom
+	if msg.Side == OrderSideBuy {
+		denom = config.DefaultStableDenom
+	}
+	if ! msg.Amount.IsValid() || len(msg.Amount) != 1 || ! msg.Amount.AmountOf(denom).IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid amount.")
+	}
+	if err := validateLimitOrderPrice(msg.Price); err != nil {
+		return sdkerrors.Wrap(ErrInvalidLimitOrder, err.Error())
+	}
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrap(ErrInvalidLimitOrder, "Duration must be positive.")
+	}
+	return nil
+}
+
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgCancelLimitOrder
+type MsgCancelLimitOrder struct {
+	Owner 	sdk.AccAddress 	`json:"owner" yaml:"owner"`
+	OrderID uint64 			`json:"order_id" yaml:"order_id"`
+}
+
+func NewMsgCancelLimitOrder(owner sdk.AccAddress, orderID uint64) MsgCancelLimitOrder {
+	return MsgCancelLimitOrder{
+		Owner: owner,
+		OrderID: orderID,
+	}
+}
+
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+
+func (msg MsgCancelLimitOrder) Type() string { return "cancel_limit_order" }
+
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	return nil
+}
+
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
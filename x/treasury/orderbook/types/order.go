@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/anathatech/project-anatha/config"
+)
+
+const (
+	OrderSideBuy 	= "buy"
+	OrderSideSell 	= "sell"
+
+	LimitOrderRouteBook 	= "book"
+	LimitOrderRouteTreasury = "treasury"
+	LimitOrderRouteSplit 	= "treasury_and_book"
+)
+
+const (
+	// MaxMatchesPerBlock bounds the fills and closes done by the matching of one end block. A book which is still
+	// crossed afterwards is matched further in the next block.
+	MaxMatchesPerBlock = 100
+)
+
+var (
+	// MaxLimitOrderPrice keeps limit order prices within the fixed width of the order book keys.
+	MaxLimitOrderPrice = sdk.NewDec(1000000000000)
+)
+
+// LimitOrder is a resting order in the PIN/DIN order book. Buy orders escrow DIN and sell orders escrow PIN, Price is
+// the limit in DIN per PIN. Remaining holds what is still escrowed and Filled what the owner received so far.
+type LimitOrder struct {
+	ID 			uint64 			`json:"id" yaml:"id"`
+	Owner 		sdk.AccAddress 	`json:"owner" yaml:"owner"`
+	Side 		string 			`json:"side" yaml:"side"`
+	Price 		sdk.Dec 		`json:"price" yaml:"price"`
+	Amount 		sdk.Coins 		`json:"amount" yaml:"amount"`
+	Remaining 	sdk.Coins 		`json:"remaining" yaml:"remaining"`
+	Filled 		sdk.Coins 		`json:"filled" yaml:"filled"`
+	CreatedAt 	time.Time 		`json:"created_at" yaml:"created_at"`
+	ExpiresAt 	time.Time 		`json:"expires_at" yaml:"expires_at"`
+}
+
+func NewLimitOrder(id uint64, owner sdk.AccAddress, side string, price sdk.Dec, amount sdk.Coins, createdAt time.Time, expiresAt time.Time) LimitOrder {
+	return LimitOrder{
+		ID: id,
+		Owner: owner,
+		Side: side,
+		Price: price,
+		Amount: amount,
+		Remaining: amount,
+		Filled: sdk.NewCoins(),
+		CreatedAt: createdAt,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// IsBuy returns true for orders buying PIN with DIN.
+func (o LimitOrder) IsBuy() bool {
+	return o.Side == OrderSideBuy
+}
+
+// EscrowDenom returns the denomination the order escrows.
+func (o LimitOrder) EscrowDenom() string {
+	if o.IsBuy() {
+		return config.DefaultStableDenom
+	}
+
+	return config.DefaultDenom
+}
+
+// RemainingAmount returns the escrowed amount which is still open.
+func (o LimitOrder) RemainingAmount() sdk.Int {
+	return o.Remaining.AmountOf(o.EscrowDenom())
+}
+
+func (o LimitOrder) String() string {
+	return fmt.Sprintf(`
+	ID: %d
+	Owner: %s
+	Side: %s
+	Price: %s
+	Amount: %s
+	Remaining: %s
+	Filled: %s
+	CreatedAt: %s
+	ExpiresAt: %s
+	`, o.ID, o.Owner, o.Side, o.Price, o.Amount, o.Remaining, o.Filled, o.CreatedAt, o.ExpiresAt)
+}
+
+// OrderBookPrice returns the price as a fixed width big endian integer of its 18 decimal places.
+func OrderBookPrice(price sdk.Dec) []byte {
+	bz := price.MulInt(sdk.NewIntWithDecimal(1, sdk.Precision)).TruncateInt().BigInt().Bytes()
+
+	key := make([]byte, lenPrice)
+	copy(key[lenPrice - len(bz):], bz)
+
+	return key
+}
+
+func validateOrderSide(side string) error {
+	if side != OrderSideBuy && side != OrderSideSell {
+		return fmt.Errorf("invalid order side: %s. expected %s or %s", side, OrderSideBuy, OrderSideSell)
+	}
+
+	return nil
+}
+
+func validateLimitOrderPrice(price sdk.Dec) error {
+	if price.IsNil() || ! price.IsPositive() || price.GT(MaxLimitOrderPrice) {
+		return fmt.Errorf("limit order price must be positive and at most %s: %s", MaxLimitOrderPrice, price)
+	}
+
+	return nil
+}
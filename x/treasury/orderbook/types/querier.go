@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+type QueryResLimitOrders []LimitOrder
+
+func (n QueryResLimitOrders) String() string {
+	var orders []string
+
+	for _, order := range n {
+		orders = append(orders, order.String())
+	}
+
+	return strings.Join(orders, "\n")
+}
+
+// QueryResOrderBook lists the bids and asks by price-time priority.
+type QueryResOrderBook struct {
+	Bids []LimitOrder `json:"bids" yaml:"bids"`
+	Asks []LimitOrder `json:"asks" yaml:"asks"`
+}
+
+func (r QueryResOrderBook) String() string {
+	return fmt.Sprintf(`Bids: %s
+Asks: %s`, QueryResLimitOrders(r.Bids), QueryResLimitOrders(r.Asks))
+}